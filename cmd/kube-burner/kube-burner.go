@@ -36,6 +36,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -81,7 +82,10 @@ func initCmd() *cobra.Command {
 	var skipTLSVerify bool
 	var timeout time.Duration
 	var userDataFile string
-	var allowMissingKeys bool
+	var allowMissingKeys, preLoadDryRun, resume bool
+	var checkpointInterval time.Duration
+	var outputFormat string
+	var measurementNames []string
 	var rc int
 	cmd := &cobra.Command{
 		Use:   "init",
@@ -92,6 +96,9 @@ func initCmd() *cobra.Command {
 		},
 		Args: cobra.NoArgs,
 		PreRun: func(cmd *cobra.Command, args []string) {
+			if resume && uuid == "" {
+				log.Fatal("--resume requires the UUID of the run to resume")
+			}
 			if uuid == "" {
 				uuid = uid.NewString()
 			}
@@ -123,6 +130,31 @@ func initCmd() *cobra.Command {
 			if err != nil {
 				log.Fatalf("Config error: %s", err.Error())
 			}
+			if outputFormat != "" {
+				if _, ok := config.OutputFormats[config.OutputFormat(outputFormat)]; !ok {
+					log.Fatalf("Invalid output format: %s", outputFormat)
+				}
+				configSpec.GlobalConfig.OutputFormat = config.OutputFormat(outputFormat)
+			}
+			if cmd.Flags().Changed("measurements") {
+				configSpec.GlobalConfig.Measurements, err = measurements.SelectMeasurements(configSpec.GlobalConfig.Measurements, measurementNames, nil)
+				if err != nil {
+					log.Fatal(err.Error())
+				}
+			}
+			if cmd.Flags().Changed("checkpoint-interval") {
+				if checkpointInterval < 0 {
+					log.Fatalf("Invalid checkpoint interval: %v", checkpointInterval)
+				}
+				configSpec.GlobalConfig.CheckpointInterval = checkpointInterval
+			}
+			configSpec.GlobalConfig.Resume = resume
+			if preLoadDryRun {
+				if err = burner.PreLoadDryRun(configSpec, kubeClientProvider, nil); err != nil {
+					log.Fatal(err.Error())
+				}
+				return
+			}
 			metricsScraper := metrics.ProcessMetricsScraperConfig(metrics.ScraperConfig{
 				ConfigSpec:      &configSpec,
 				MetricsEndpoint: metricsEndpoint,
@@ -154,6 +186,11 @@ func initCmd() *cobra.Command {
 	cmd.Flags().StringVar(&kubeContext, "kube-context", "", "The name of the kubeconfig context to use")
 	cmd.Flags().StringVar(&userDataFile, "user-data", "", "User provided data file for rendering the configuration file, in JSON or YAML format")
 	cmd.Flags().BoolVar(&allowMissingKeys, "allow-missing", false, "Do not fail on missing values in the config file")
+	cmd.Flags().BoolVar(&preLoadDryRun, "preload-dry-run", false, "Print the images to pre-load by each job and exit without running the benchmark")
+	cmd.Flags().StringVar(&outputFormat, "output-format", "", "Measurement summary format, json or csv. csv also writes the measurement quantiles into a CSV file, overrides the outputFormat of the configuration")
+	cmd.Flags().StringSliceVar(&measurementNames, "measurements", nil, "Comma-separated list of measurements to run, overrides the measurements of the configuration. Measurements not configured run with their default configuration, an empty list disables all of them")
+	cmd.Flags().DurationVar(&checkpointInterval, "checkpoint-interval", 0, "Interval between writes of the run checkpoint, used to resume the run with --resume, overrides the checkpointInterval of the configuration")
+	cmd.Flags().BoolVar(&resume, "resume", false, "Resume the run with the given UUID from its checkpoint, skipping the objects already created")
 	cmd.Flags().SortFlags = false
 	cmd.MarkFlagsMutuallyExclusive("config", "configmap")
 	return cmd
@@ -215,6 +252,52 @@ func destroyCmd() *cobra.Command {
 	return cmd
 }
 
+func gcCmd() *cobra.Command {
+	var selector string
+	var timeout time.Duration
+	var dryRun bool
+	var kubeConfig, kubeContext string
+	var rc int
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Garbage collect namespaces left behind by previous runs",
+		PostRun: func(cmd *cobra.Command, args []string) {
+			log.Info("👋 Exiting kube-burner")
+			os.Exit(rc)
+		},
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.SetupFileLogging("gc-" + uid.NewString())
+			clientSet, _ := config.NewKubeClientProvider(kubeConfig, kubeContext).ClientSet(0, 0)
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			if dryRun {
+				nsList, err := clientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: selector})
+				if err != nil {
+					log.Errorf("Error listing namespaces: %v", err)
+					rc = 1
+					return
+				}
+				log.Infof("Dry run: %d namespaces labeled with %s would be deleted", len(nsList.Items), selector)
+				for _, ns := range nsList.Items {
+					log.Infof("Namespace %s (uuid: %s, job: %s)", ns.Name, ns.Labels["kube-burner-uuid"], ns.Labels["kube-burner-job"])
+				}
+				return
+			}
+			if err := util.CleanupNamespaces(ctx, clientSet, selector); err != nil {
+				log.Error(err)
+				rc = 1
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&selector, "selector", "l", "kube-burner-uuid", "Label selector of the namespaces to delete, by default all namespaces created by kube-burner, including preload namespaces")
+	cmd.Flags().DurationVarP(&timeout, "timeout", "", 4*time.Hour, "Deletion timeout")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "List the namespaces that would be deleted without deleting them")
+	cmd.Flags().StringVar(&kubeConfig, "kubeconfig", "", "Path to the kubeconfig file")
+	cmd.Flags().StringVar(&kubeContext, "kube-context", "", "The name of the kubeconfig context to use")
+	return cmd
+}
+
 func measureCmd() *cobra.Command {
 	var uuid string
 	var rawNamespaces string
@@ -247,7 +330,7 @@ func measureCmd() *cobra.Command {
 			}
 			for pos, indexer := range configSpec.MetricsEndpoints {
 				log.Infof("📁 Creating indexer: %s", indexer.Type)
-				idx, err := indexers.NewIndexer(indexer.IndexerConfig)
+				idx, err := metrics.NewIndexer(indexer.IndexerConfig)
 				if err != nil {
 					log.Fatalf("Error creating indexer %d: %v", pos, err.Error())
 				}
@@ -337,13 +420,13 @@ func indexCmd() *cobra.Command {
 				SkipTLSVerify: skipTLSVerify,
 			}
 			if esServer != "" && esIndex != "" {
-				indexer.IndexerConfig = indexers.IndexerConfig{
+				indexer.IndexerConfig.IndexerConfig = indexers.IndexerConfig{
 					Type:    indexers.ElasticIndexer,
 					Servers: []string{esServer},
 					Index:   esIndex,
 				}
 			} else {
-				indexer.IndexerConfig = indexers.IndexerConfig{
+				indexer.IndexerConfig.IndexerConfig = indexers.IndexerConfig{
 					Type:             indexers.LocalIndexer,
 					MetricsDirectory: metricsDirectory,
 					TarballName:      tarballName,
@@ -368,7 +451,7 @@ func indexCmd() *cobra.Command {
 				}
 			}
 			if configSpec.MetricsEndpoints[0].Type == indexers.LocalIndexer && tarballName != "" {
-				if err := metrics.CreateTarball(configSpec.MetricsEndpoints[0].IndexerConfig); err != nil {
+				if err := metrics.CreateTarball(configSpec.MetricsEndpoints[0].IndexerConfig.IndexerConfig); err != nil {
 					log.Fatal(err)
 				}
 			}
@@ -525,6 +608,7 @@ func main() {
 		initCmd(),
 		measureCmd(),
 		destroyCmd(),
+		gcCmd(),
 		healthCheck(),
 		indexCmd(),
 		alertCmd(),
@@ -45,6 +45,13 @@ const (
 	rcAlert                       = 3
 )
 
+// severityOrder ranks the severity levels, from lowest to highest
+var severityOrder = map[severityLevel]int{
+	sevWarn:     1,
+	sevError:    2,
+	sevCritical: 3,
+}
+
 // alertProfile expression list
 type alertProfile []struct {
 	// PromQL expression to evaluate
@@ -136,7 +143,7 @@ func (a *AlertManager) Evaluate(job prometheus.Job) error {
 		expr := renderedQuery.String()
 		renderedQuery.Reset()
 		log.Debugf("Evaluating expression: '%s'", expr)
-		v, err := a.prometheus.Client.QueryRange(expr, job.Start, job.End, a.prometheus.Step)
+		v, err := a.prometheus.QueryRange(expr, job.Start, job.End, a.prometheus.Step)
 		if err != nil {
 			log.Warnf("Error performing query %s: %s", expr, err)
 			continue
@@ -154,6 +161,43 @@ func (a *AlertManager) Evaluate(job prometheus.Job) error {
 	return utilerrors.NewAggregate(errs)
 }
 
+// Firing evaluates at the current time the expressions with a severity equal or higher than the given one,
+// returning the description of the first alert firing. elapsed is rendered with the time since start
+func (a *AlertManager) Firing(severity string, start time.Time) (string, bool) {
+	var renderedQuery, renderedDesc bytes.Buffer
+	vars := util.EnvToMap()
+	vars["elapsed"] = fmt.Sprintf("%dm", int(time.Since(start).Minutes()))
+	for _, alert := range a.alertProfile {
+		if severityOrder[alert.Severity] < severityOrder[severityLevel(severity)] {
+			continue
+		}
+		renderedQuery.Reset()
+		t, _ := template.New("").Parse(alert.Expr)
+		t.Execute(&renderedQuery, vars)
+		expr := renderedQuery.String()
+		log.Debugf("Evaluating expression: '%s'", expr)
+		v, err := a.prometheus.Query(expr, time.Now().UTC())
+		if err != nil {
+			log.Warnf("Error performing query %s: %s", expr, err)
+			continue
+		}
+		vector, ok := v.(model.Vector)
+		if !ok || len(vector) == 0 {
+			continue
+		}
+		templateData := descriptionTemplate{Labels: make(map[string]string), Value: math.Round(float64(vector[0].Value)*1000) / 1000}
+		for k, v := range vector[0].Metric {
+			templateData.Labels[string(k)] = string(v)
+		}
+		t, _ = template.New("").Parse(strings.Join(append(baseTemplate, alert.Description), ""))
+		if err := t.Execute(&renderedDesc, templateData); err != nil {
+			log.Errorf("alert rendering error: %s", err)
+		}
+		return fmt.Sprintf("%s alert: '%s'", alert.Severity, renderedDesc.String()), true
+	}
+	return "", false
+}
+
 func (a *AlertManager) validateTemplates() error {
 	for _, a := range a.alertProfile {
 		if _, err := template.New("").Parse(strings.Join(append(baseTemplate, a.Description), "")); err != nil {
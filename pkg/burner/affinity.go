@@ -0,0 +1,70 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"slices"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// jobAffinity returns the affinity of the job in unstructured format, nil when not set
+func jobAffinity(job config.Job) map[string]any {
+	affinity, err := job.PodAffinity()
+	if affinity == nil || err != nil {
+		return nil
+	}
+	affinityObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(affinity)
+	if err != nil {
+		log.Fatalf("Error preparing the affinity of job %s: %v", job.Name, err)
+	}
+	return affinityObj
+}
+
+// mergeAffinity merges the affinity of the job into the pod spec of the object, objects without pod spec are left untouched.
+// Preferred terms, and the required terms of podAffinity and podAntiAffinity, are appended to the ones of the template.
+// The required nodeAffinity of the job overrides the one of the template, as its node selector terms are ORed and appending them
+// would loosen the template constraint. It returns true when the required nodeAffinity of the template was overridden
+func mergeAffinity(obj *unstructured.Unstructured, affinity map[string]any) bool {
+	podSpecPath, ok := kindToPodSpecPath[obj.GetKind()]
+	if !ok {
+		return false
+	}
+	var overridden bool
+	for affinityType, rules := range affinity {
+		rulesMap, ok := rules.(map[string]any)
+		if !ok {
+			continue
+		}
+		rulesPath := append(slices.Clone(podSpecPath), "affinity", affinityType)
+		for termsType, terms := range rulesMap {
+			termsPath := append(slices.Clone(rulesPath), termsType)
+			if jobTerms, ok := terms.([]any); ok {
+				templateTerms, _, _ := unstructured.NestedSlice(obj.Object, termsPath...)
+				unstructured.SetNestedSlice(obj.Object, append(templateTerms, runtime.DeepCopyJSONValue(jobTerms).([]any)...), termsPath...)
+				continue
+			}
+			// The required nodeAffinity is a node selector rather than a list of terms
+			if _, found, _ := unstructured.NestedFieldNoCopy(obj.Object, termsPath...); found {
+				overridden = true
+			}
+			unstructured.SetNestedField(obj.Object, runtime.DeepCopyJSONValue(terms), termsPath...)
+		}
+	}
+	return overridden
+}
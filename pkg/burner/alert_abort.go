@@ -0,0 +1,53 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/alerting"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// watchAlerts evaluates the alert profiles on every interval until the context is done. When an alert
+// with the configured severity fires, it's sent through abortCh and the benchmark context is cancelled,
+// so no more objects are created
+func watchAlerts(ctx context.Context, cancel context.CancelFunc, alertMs []*alerting.AlertManager, alertAbort config.AlertAbort, abortCh chan<- string) {
+	if len(alertMs) == 0 {
+		log.Warn("alertAbort requires an alert profile, alerts won't be evaluated during the benchmark")
+		return
+	}
+	log.Infof("🔔 Evaluating alerts with severity %s or higher every %v", alertAbort.Severity, alertAbort.Interval)
+	start := time.Now().UTC()
+	ticker := time.NewTicker(alertAbort.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, alertM := range alertMs {
+				if firingAlert, firing := alertM.Firing(alertAbort.Severity, start); firing {
+					log.Errorf("🚨 Aborting benchmark, %s", firingAlert)
+					abortCh <- firingAlert
+					cancel()
+					return
+				}
+			}
+		}
+	}
+}
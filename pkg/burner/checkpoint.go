@@ -0,0 +1,260 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultCheckpointInterval = 30 * time.Second
+
+// checkpoint tracks the progress of the jobs of a run, periodically written to a local file so the run can be resumed
+type checkpoint struct {
+	mu    sync.Mutex
+	path  string
+	uuid  string
+	runid string
+	jobs  map[string]*jobCheckpoint
+}
+
+// checkpointFile is the content of the checkpoint file.
+// The RunID is kept so the objects created before resuming are still selected by the measurements
+type checkpointFile struct {
+	UUID  string                 `json:"uuid"`
+	RunID string                 `json:"runid"`
+	Jobs  map[string]jobProgress `json:"jobs"`
+}
+
+// jobProgress progress of a job
+type jobProgress struct {
+	// Iterations the objects of all the iterations below this one were created
+	Iterations int `json:"iterations"`
+	// Completed the job finished, it's skipped when resuming
+	Completed bool `json:"completed"`
+}
+
+// jobCheckpoint tracks the creation requests of the iterations of a job
+type jobCheckpoint struct {
+	mu       sync.Mutex
+	progress jobProgress
+	// next iteration to be started by the creation loop
+	next int
+	// pending number of creation requests in flight per iteration
+	pending map[int]int
+}
+
+func checkpointPath(uuid string) string {
+	return fmt.Sprintf("kube-burner-%s.checkpoint.json", uuid)
+}
+
+// newCheckpoint returns the checkpoint of the run, loaded from its file when resuming
+func newCheckpoint(uuid, runid string, resume bool) (*checkpoint, error) {
+	cp := &checkpoint{
+		path:  checkpointPath(uuid),
+		uuid:  uuid,
+		runid: runid,
+		jobs:  map[string]*jobCheckpoint{},
+	}
+	if !resume {
+		return cp, nil
+	}
+	var cpFile checkpointFile
+	data, err := os.ReadFile(cp.path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading checkpoint: %v", err)
+	}
+	if err := json.Unmarshal(data, &cpFile); err != nil {
+		return nil, fmt.Errorf("error decoding checkpoint %s: %v", cp.path, err)
+	}
+	if cpFile.UUID != uuid {
+		return nil, fmt.Errorf("checkpoint %s belongs to UUID %s", cp.path, cpFile.UUID)
+	}
+	cp.runid = cpFile.RunID
+	for name, progress := range cpFile.Jobs {
+		if progress.Completed {
+			log.Infof("Resuming run %s: job %s already completed", uuid, name)
+		} else {
+			log.Infof("Resuming run %s: job %s from iteration %d", uuid, name, progress.Iterations)
+		}
+		cp.jobs[name] = &jobCheckpoint{progress: progress}
+	}
+	return cp, nil
+}
+
+// job returns the checkpoint of the given job
+func (cp *checkpoint) job(name string) *jobCheckpoint {
+	if cp == nil {
+		return nil
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	jobCp, exists := cp.jobs[name]
+	if !exists {
+		jobCp = &jobCheckpoint{}
+		cp.jobs[name] = jobCp
+	}
+	jobCp.next = jobCp.progress.Iterations
+	jobCp.pending = map[int]int{}
+	return jobCp
+}
+
+// write writes the checkpoint file, replacing the previous one atomically
+func (cp *checkpoint) write() {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cpFile := checkpointFile{
+		UUID:  cp.uuid,
+		RunID: cp.runid,
+		Jobs:  make(map[string]jobProgress, len(cp.jobs)),
+	}
+	for name, jobCp := range cp.jobs {
+		cpFile.Jobs[name] = jobCp.update()
+	}
+	data, err := json.Marshal(cpFile)
+	if err != nil {
+		log.Errorf("Error encoding checkpoint: %v", err)
+		return
+	}
+	tmpPath := cp.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		log.Errorf("Error writing checkpoint: %v", err)
+		return
+	}
+	if err := os.Rename(tmpPath, cp.path); err != nil {
+		log.Errorf("Error writing checkpoint: %v", err)
+	}
+}
+
+// start writes the checkpoint periodically, the returned function stops the periodic writes and writes it a last time
+func (cp *checkpoint) start(interval time.Duration) func() {
+	log.Infof("Checkpointing run %s every %v into %s", cp.uuid, interval, cp.path)
+	stopCh := make(chan struct{})
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-ticker.C:
+				cp.write()
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(stopCh)
+			<-doneCh
+			cp.write()
+		})
+	}
+}
+
+// remove removes the checkpoint file once the run finishes
+func (cp *checkpoint) remove() {
+	if err := os.Remove(cp.path); err != nil && !os.IsNotExist(err) {
+		log.Errorf("Error removing checkpoint: %v", err)
+	}
+}
+
+// resumeIteration returns the iteration the job creation is resumed from
+func (jc *jobCheckpoint) resumeIteration() int {
+	if jc == nil {
+		return 0
+	}
+	jc.mu.Lock()
+	defer jc.mu.Unlock()
+	return jc.progress.Iterations
+}
+
+// completed returns true when the job finished in a previous execution of the run
+func (jc *jobCheckpoint) completed() bool {
+	if jc == nil {
+		return false
+	}
+	jc.mu.Lock()
+	defer jc.mu.Unlock()
+	return jc.progress.Completed
+}
+
+// complete flags the job as completed
+func (jc *jobCheckpoint) complete() {
+	if jc == nil {
+		return
+	}
+	jc.mu.Lock()
+	defer jc.mu.Unlock()
+	jc.progress.Completed = true
+}
+
+// repetitionIterations returns the range of iterations created by the given repetition,
+// skipping the ones created before resuming from the given iteration
+func repetitionIterations(repetition, jobIterations, resumeIteration int) (int, int) {
+	iterationEnd := (repetition + 1) * jobIterations
+	return min(max(repetition*jobIterations, resumeIteration), iterationEnd), iterationEnd
+}
+
+// startIteration records that the creation loop started the given iteration, so all the creation requests of the previous ones were issued
+func (jc *jobCheckpoint) startIteration(iteration int) {
+	if jc == nil {
+		return
+	}
+	jc.mu.Lock()
+	defer jc.mu.Unlock()
+	jc.next = iteration
+}
+
+// addRequest records a creation request of the given iteration
+func (jc *jobCheckpoint) addRequest(iteration int) {
+	if jc == nil {
+		return
+	}
+	jc.mu.Lock()
+	defer jc.mu.Unlock()
+	jc.pending[iteration]++
+}
+
+// doneRequest records a completed creation request of the given iteration
+func (jc *jobCheckpoint) doneRequest(iteration int) {
+	if jc == nil {
+		return
+	}
+	jc.mu.Lock()
+	defer jc.mu.Unlock()
+	if jc.pending[iteration]--; jc.pending[iteration] <= 0 {
+		delete(jc.pending, iteration)
+	}
+}
+
+// update sets the created iterations to the lowest iteration with creation requests pending or not issued yet, and returns the job progress
+func (jc *jobCheckpoint) update() jobProgress {
+	jc.mu.Lock()
+	defer jc.mu.Unlock()
+	iterations := jc.next
+	for iteration := range jc.pending {
+		iterations = min(iterations, iteration)
+	}
+	jc.progress.Iterations = max(jc.progress.Iterations, iterations)
+	return jc.progress
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"os"
+	"testing"
+)
+
+func TestJobCheckpointUpdate(t *testing.T) {
+	cp := &checkpoint{jobs: map[string]*jobCheckpoint{}}
+	jc := cp.job("create")
+	jc.startIteration(0)
+	jc.addRequest(0)
+	jc.addRequest(1)
+	jc.addRequest(1)
+	jc.startIteration(2)
+	if got := jc.update().Iterations; got != 0 {
+		t.Errorf("expected 0 iterations with iteration 0 pending, got %d", got)
+	}
+	jc.doneRequest(0)
+	if got := jc.update().Iterations; got != 1 {
+		t.Errorf("expected 1 iteration with iteration 1 pending, got %d", got)
+	}
+	jc.doneRequest(1)
+	if got := jc.update().Iterations; got != 1 {
+		t.Errorf("expected 1 iteration with a request of iteration 1 pending, got %d", got)
+	}
+	jc.doneRequest(1)
+	if got := jc.update().Iterations; got != 2 {
+		t.Errorf("expected 2 iterations once all the requests completed, got %d", got)
+	}
+	// Progress never goes backwards, i.e. when a later repetition restarts the creation loop
+	jc.startIteration(1)
+	if got := jc.update().Iterations; got != 2 {
+		t.Errorf("expected progress to be kept, got %d", got)
+	}
+}
+
+func TestCheckpointResume(t *testing.T) {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	cp, err := newCheckpoint("uuid", "runid", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cp.job("first").complete()
+	second := cp.job("second")
+	second.startIteration(7)
+	cp.write()
+	resumed, err := newCheckpoint("uuid", "other-runid", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resumed.runid != "runid" {
+		t.Errorf("expected the runid of the checkpoint, got %s", resumed.runid)
+	}
+	if !resumed.job("first").completed() {
+		t.Error("expected first job to be completed")
+	}
+	if got := resumed.job("second").resumeIteration(); got != 7 {
+		t.Errorf("expected second job to resume from iteration 7, got %d", got)
+	}
+	// Jobs not reached before the checkpoint start from scratch, so their initial cleanup runs
+	if got := resumed.job("third").resumeIteration(); got != 0 {
+		t.Errorf("expected third job to resume from iteration 0, got %d", got)
+	}
+	if _, err := newCheckpoint("other-uuid", "runid", true); err == nil {
+		t.Error("expected error resuming a checkpoint of another UUID")
+	}
+}
+
+func TestRepetitionIterations(t *testing.T) {
+	tests := []struct {
+		name            string
+		repetition      int
+		resumeIteration int
+		expectedStart   int
+		expectedEnd     int
+	}{
+		{"first repetition", 0, 0, 0, 10},
+		{"resumed first repetition", 0, 4, 4, 10},
+		{"later repetition", 2, 0, 20, 30},
+		{"repetition resumed", 2, 25, 25, 30},
+		{"repetition created before resuming", 1, 25, 20, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := repetitionIterations(tt.repetition, 10, tt.resumeIteration)
+			if start != tt.expectedStart || end != tt.expectedEnd {
+				t.Errorf("expected [%d, %d), got [%d, %d)", tt.expectedStart, tt.expectedEnd, start, end)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+)
+
+func TestChurnIterationsFIFO(t *testing.T) {
+	tests := []struct {
+		name       string
+		iterations int
+		numToChurn int
+		cycle      int
+		expected   []int
+	}{
+		{name: "first cycle", iterations: 10, numToChurn: 3, expected: []int{0, 1, 2}},
+		{name: "following cycle", iterations: 10, numToChurn: 3, cycle: 1, expected: []int{3, 4, 5}},
+		{name: "wraps around", iterations: 10, numToChurn: 4, cycle: 2, expected: []int{0, 1, 8, 9}},
+		{name: "capped to job iterations", iterations: 3, numToChurn: 5, expected: []int{0, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ex := &Executor{Job: config.Job{JobIterations: tt.iterations, ChurnDeletionStrategy: config.ChurnDeletionFIFO}}
+			if iterations := ex.churnIterations(tt.cycle, tt.numToChurn); !slices.Equal(iterations, tt.expected) {
+				t.Errorf("iterations %v, expected %v", iterations, tt.expected)
+			}
+		})
+	}
+}
+
+func TestChurnIterationsRandom(t *testing.T) {
+	for _, strategy := range []string{config.ChurnDeletionRandom, config.ChurnDeletionDefault} {
+		ex := &Executor{Job: config.Job{JobIterations: 20, ChurnDeletionStrategy: strategy}}
+		for range 50 {
+			iterations := ex.churnIterations(0, 5)
+			if len(iterations) != 5 || !slices.IsSorted(iterations) || iterations[0] < 0 || iterations[4] >= 20 {
+				t.Fatalf("%s: invalid iterations %v", strategy, iterations)
+			}
+			if len(slices.Compact(slices.Clone(iterations))) != 5 {
+				t.Fatalf("%s: duplicated iterations %v", strategy, iterations)
+			}
+			if strategy == config.ChurnDeletionDefault && iterations[4]-iterations[0] != 4 {
+				t.Fatalf("%s: iterations %v aren't contiguous", strategy, iterations)
+			}
+		}
+	}
+}
+
+func TestNamespaceIterations(t *testing.T) {
+	tests := []struct {
+		name                   string
+		jobIterations          int
+		iterationsPerNamespace int
+		iterations             []int
+		expected               []int
+	}{
+		{name: "one iteration per namespace", jobIterations: 10, iterationsPerNamespace: 1, iterations: []int{2, 5}, expected: []int{2, 5}},
+		{name: "whole namespaces", jobIterations: 10, iterationsPerNamespace: 3, iterations: []int{1, 2, 7}, expected: []int{0, 1, 2, 6, 7, 8}},
+		{name: "last namespace partially filled", jobIterations: 10, iterationsPerNamespace: 4, iterations: []int{9}, expected: []int{8, 9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ex := &Executor{Job: config.Job{JobIterations: tt.jobIterations, IterationsPerNamespace: tt.iterationsPerNamespace}}
+			if iterations := ex.namespaceIterations(tt.iterations); !slices.Equal(iterations, tt.expected) {
+				t.Errorf("iterations %v, expected %v", iterations, tt.expected)
+			}
+		})
+	}
+}
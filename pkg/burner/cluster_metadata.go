@@ -0,0 +1,70 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"slices"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	nodeCount         = "NodeCount"
+	k8sVersion        = "K8sVersion"
+	platform          = "Platform"
+	openShiftAPIGroup = "config.openshift.io"
+)
+
+// getClusterMetadata returns the cluster facts injected into the object templates.
+// The facts that can't be fetched are left out, so templates referencing them fail unless defaultMissingKeysWithZero is enabled
+func getClusterMetadata(clientSet kubernetes.Interface) map[string]any {
+	clusterMetadata := make(map[string]any)
+	nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		log.Warnf("Error listing nodes, %s won't be available in templates: %v", nodeCount, err)
+	} else {
+		clusterMetadata[nodeCount] = len(nodes.Items)
+	}
+	serverVersion, err := clientSet.Discovery().ServerVersion()
+	if err != nil {
+		log.Warnf("Error getting server version, %s won't be available in templates: %v", k8sVersion, err)
+	} else {
+		clusterMetadata[k8sVersion] = serverVersion.GitVersion
+	}
+	clusterPlatform, err := detectPlatform(clientSet)
+	if err != nil {
+		log.Warnf("Error getting API groups, %s won't be available in templates: %v", platform, err)
+	} else {
+		clusterMetadata[platform] = string(clusterPlatform)
+	}
+	log.Debugf("Cluster metadata: %v", clusterMetadata)
+	return clusterMetadata
+}
+
+// detectPlatform returns OpenShift when the cluster serves the OpenShift config API group, Kubernetes otherwise
+func detectPlatform(clientSet kubernetes.Interface) (config.Platform, error) {
+	apiGroups, err := clientSet.Discovery().ServerGroups()
+	if err != nil {
+		return "", err
+	}
+	if slices.ContainsFunc(apiGroups.Groups, func(g metav1.APIGroup) bool { return g.Name == openShiftAPIGroup }) {
+		return config.PlatformOpenShift, nil
+	}
+	return config.PlatformKubernetes, nil
+}
@@ -89,6 +89,19 @@ func (ccc *ConditionCheckConfig) toStatusPaths(timeUTC int64) []config.StatusPat
 	return statusPath
 }
 
+// waitConditionToStatusPaths returns the status paths verifying the given condition, status defaults to True
+func waitConditionToStatusPaths(waitCondition config.WaitCondition) []config.StatusPath {
+	status := waitCondition.Status
+	if status == "" {
+		status = "True"
+	}
+	ccc := ConditionCheckConfig{
+		conditionType:        ConditionType(waitCondition.Type),
+		conditionCheckParams: []ConditionCheckParam{newConditionCheckParam(conditionFieldStatus, status)},
+	}
+	return ccc.toStatusPaths(0)
+}
+
 // Helper reusable variables
 var (
 	conditionCheckParamStatusTrue = newConditionCheckParam(conditionFieldStatus, "True")
@@ -6,6 +6,7 @@ const (
 	DaemonSet                        = "DaemonSet"
 	ReplicaSet                       = "ReplicaSet"
 	Job                              = "Job"
+	CronJob                          = "CronJob"
 	Pod                              = "Pod"
 	ReplicationController            = "ReplicationController"
 	Build                            = "Build"
@@ -14,6 +15,7 @@ const (
 	VirtualMachineInstance           = "VirtualMachineInstance"
 	VirtualMachineInstanceReplicaSet = "VirtualMachineInstanceReplicaSet"
 	PersistentVolumeClaim            = "PersistentVolumeClaim"
+	Secret                           = "Secret"
 	VolumeSnapshot                   = "VolumeSnapshot"
 	DataVolume                       = "DataVolume"
 	DataSource                       = "DataSource"
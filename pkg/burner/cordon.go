@@ -0,0 +1,89 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const cordonedByAnnotation = "kube-burner.io/cordoned-by"
+
+// cordonNodes cordons the configured percentage of the nodes matching the label selector, skipping the nodes already
+// unschedulable. The cordoned nodes are annotated with the benchmark UUID, and they're uncordoned by the returned function
+func cordonNodes(clientSet kubernetes.Interface, cordon config.Cordon, uuid string) (func(), error) {
+	selector := labels.SelectorFromSet(cordon.LabelSelector).String()
+	nodeList, err := clientSet.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes to cordon: %v", err)
+	}
+	var candidates []string
+	for _, node := range nodeList.Items {
+		if !node.Spec.Unschedulable {
+			candidates = append(candidates, node.Name)
+		}
+	}
+	percent := cordon.Percent
+	if percent == 0 {
+		percent = 100
+	}
+	count := int(math.Ceil(float64(len(candidates)*percent) / 100))
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	var cordoned []string
+	for _, node := range candidates[:count] {
+		if err := setUnschedulable(clientSet, node, true, uuid); err != nil {
+			log.Errorf("Error cordoning node %s: %v", node, err)
+			continue
+		}
+		cordoned = append(cordoned, node)
+	}
+	sort.Strings(cordoned)
+	log.Infof("Cordoned %d out of %d schedulable nodes matching %q: %v", len(cordoned), len(candidates), selector, cordoned)
+	return func() {
+		for _, node := range cordoned {
+			if err := setUnschedulable(clientSet, node, false, uuid); err != nil {
+				log.Errorf("Error uncordoning node %s: %v", node, err)
+			}
+		}
+		log.Infof("Uncordoned nodes: %v", cordoned)
+	}, nil
+}
+
+// setUnschedulable cordons or uncordons the node, the annotation records the benchmark which cordoned it
+func setUnschedulable(clientSet kubernetes.Interface, node string, unschedulable bool, uuid string) error {
+	annotation := fmt.Sprintf("%q", uuid)
+	if !unschedulable {
+		annotation = "null"
+	}
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%s}},"spec":{"unschedulable":%t}}`, cordonedByAnnotation, annotation, unschedulable)
+	// The nodes are uncordoned with a new context as the benchmark one can be already cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err := clientSet.CoreV1().Nodes().Patch(ctx, node, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	return err
+}
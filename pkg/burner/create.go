@@ -20,6 +20,7 @@ import (
 	"io"
 	"math"
 	"math/rand"
+	"slices"
 	"strconv"
 	"sync"
 	"time"
@@ -31,23 +32,40 @@ import (
 	"github.com/kube-burner/kube-burner/pkg/config"
 	"github.com/kube-burner/kube-burner/pkg/util"
 	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	"golang.org/x/time/rate"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
 )
 
 func (ex *Executor) setupCreateJob(mapper meta.RESTMapper) {
 	var err error
 	var f io.Reader
+	var clusterPlatform config.Platform
 	log.Debugf("Preparing create job: %s", ex.Name)
 	for _, o := range ex.Objects {
 		if o.Replicas < 1 {
 			log.Warnf("Object template %s has replicas %d < 1, skipping", o.ObjectTemplate, o.Replicas)
 			continue
 		}
+		if len(o.EnabledOn) > 0 || len(o.SkipOn) > 0 {
+			if clusterPlatform == "" {
+				if clusterPlatform, err = detectPlatform(ex.clientSet); err != nil {
+					log.Fatalf("Error detecting the cluster platform required by object %s: %v", o.ObjectTemplate, err)
+				}
+			}
+			if !platformEnabled(o, clusterPlatform) {
+				log.Infof("Job %s: object %s doesn't apply to %s, skipping", ex.Name, o.ObjectTemplate, clusterPlatform)
+				ex.skippedObjects = append(ex.skippedObjects, o.ObjectTemplate)
+				continue
+			}
+		}
 		log.Debugf("Rendering template: %s", o.ObjectTemplate)
 		f, err = fileutils.GetWorkloadReader(o.ObjectTemplate, ex.embedCfg)
 		if err != nil {
@@ -69,20 +87,62 @@ func (ex *Executor) setupCreateJob(mapper meta.RESTMapper) {
 			log.Fatal(err)
 		}
 		obj := &object{
-			gvr:        mapping.Resource,
-			objectSpec: t,
-			Object:     o,
-			namespace:  uns.GetNamespace(),
-			namespaced: mapping.Scope.Name() == meta.RESTScopeNameNamespace,
+			gvr:                mapping.Resource,
+			objectSpec:         t,
+			fileFuncs:          templateFileFuncs(o.ObjectTemplate, ex.embedCfg),
+			Object:             o,
+			namespace:          uns.GetNamespace(),
+			namespaced:         mapping.Scope.Name() == meta.RESTScopeNameNamespace,
+			affinityOverridden: &sync.Once{},
 		}
 		obj.Kind = gvk.Kind
+		if o.QPS > 0 {
+			burst := o.Burst
+			if burst == 0 {
+				burst = max(int(o.QPS), 1)
+			}
+			obj.limiter = rate.NewLimiter(rate.Limit(o.QPS), burst)
+			log.Infof("Job %s: %s replicas created at %v QPS and %d burst", ex.Name, gvk.Kind, o.QPS, burst)
+		}
 		// Job requires namespaces when one of the objects is namespaced and doesn't have any namespace specified
 		if obj.namespaced && obj.namespace == "" {
 			ex.nsRequired = true
 		}
 		log.Infof("Job %s: %d iterations with %d %s replicas", ex.Name, ex.JobIterations, obj.Replicas, gvk.Kind)
 		ex.objects = append(ex.objects, obj)
+		ex.totalWeight += obj.Weight
+	}
+	ex.createWaves = createWaves(ex.objects)
+	if len(ex.createWaves) > 1 {
+		log.Infof("Job %s: objects are created in %d waves", ex.Name, len(ex.createWaves))
+	}
+}
+
+// platformEnabled returns whether the object is created on the given platform according to its enabledOn and skipOn predicates
+func platformEnabled(o config.Object, clusterPlatform config.Platform) bool {
+	if slices.Contains(o.SkipOn, clusterPlatform) {
+		return false
+	}
+	return len(o.EnabledOn) == 0 || slices.Contains(o.EnabledOn, clusterPlatform)
+}
+
+// createWaves groups the objects by createOrder keeping the declaration order within each wave,
+// objects without createOrder are grouped in the last wave
+func createWaves(objects []*object) [][]int {
+	waveMap := make(map[int][]int)
+	for objectIndex, obj := range objects {
+		waveMap[obj.CreateOrder] = append(waveMap[obj.CreateOrder], objectIndex)
+	}
+	orders := slices.Sorted(maps.Keys(waveMap))
+	// Unordered objects go last
+	if len(orders) > 0 && orders[0] == 0 {
+		orders = append(orders[1:], 0)
 	}
+	var waves [][]int
+	for _, order := range orders {
+		waves = append(waves, waveMap[order])
+	}
+	return waves
 }
 
 // RunCreateJob executes a creation job
@@ -100,7 +160,12 @@ func (ex *Executor) RunCreateJob(ctx context.Context, iterationStart, iterationE
 	maps.Copy(nsAnnotations, ex.NamespaceAnnotations)
 	if ex.nsRequired && !ex.NamespacedIterations {
 		ns = ex.Namespace
-		if err = util.CreateNamespace(ex.clientSet, ns, nsLabels, nsAnnotations); err != nil {
+		if ex.NamespacedBy == config.NamespacedByExisting {
+			// The namespace lifecycle isn't managed by kube-burner
+			if _, err = ex.clientSet.CoreV1().Namespaces().Get(context.TODO(), ns, metav1.GetOptions{}); err != nil {
+				log.Fatalf("Error getting existing namespace %s: %v", ns, err)
+			}
+		} else if err = util.CreateNamespace(ex.clientSet, ns, nsLabels, nsAnnotations); err != nil {
 			log.Fatal(err.Error())
 		}
 		*waitListNamespaces = append(*waitListNamespaces, ns)
@@ -111,9 +176,11 @@ func (ex *Executor) RunCreateJob(ctx context.Context, iterationStart, iterationE
 	var namespacesCreated = make(map[string]bool)
 	var namespacesWaited = make(map[string]bool)
 	for i := iterationStart; i < iterationEnd; i++ {
+		creationPause.wait(ctx)
 		if ctx.Err() != nil {
 			return
 		}
+		ex.checkpoint.startIteration(i)
 		if i == iterationStart+iterationProgress*percent {
 			log.Infof("%v/%v iterations completed", i-iterationStart, iterationEnd-iterationStart)
 			percent++
@@ -122,6 +189,14 @@ func (ex *Executor) RunCreateJob(ctx context.Context, iterationStart, iterationE
 		if ex.nsRequired && ex.NamespacedIterations {
 			ns = ex.generateNamespace(i)
 			if !namespacesCreated[ns] {
+				if ex.namespaceBatchCompleted(len(namespacesCreated)) {
+					log.Infof("%d namespaces created, pausing for %v before the next batch", len(namespacesCreated), ex.NamespaceBatchPause)
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(ex.NamespaceBatchPause):
+					}
+				}
 				if err = util.CreateNamespace(ex.clientSet, ns, nsLabels, nsAnnotations); err != nil {
 					log.Error(err.Error())
 					continue
@@ -130,23 +205,35 @@ func (ex *Executor) RunCreateJob(ctx context.Context, iterationStart, iterationE
 				*waitListNamespaces = append(*waitListNamespaces, ns)
 			}
 		}
-		for objectIndex, obj := range ex.objects {
-			labels := map[string]string{
-				"kube-burner-uuid":                 ex.uuid,
-				"kube-burner-job":                  ex.Name,
-				"kube-burner-index":                strconv.Itoa(objectIndex),
-				"kube-burner-runid":                ex.runid,
-				config.KubeBurnerLabelJobIteration: strconv.Itoa(i),
-			}
-			ex.objects[objectIndex].LabelSelector = labels
-			if obj.RunOnce {
-				if i == 0 {
-					// this executes only once during the first iteration of an object
-					log.Debugf("RunOnce set to %s, so creating object once", obj.ObjectTemplate)
+		selectedObject := ex.weightedObject(i)
+		for waveIndex, wave := range ex.createWaves {
+			for _, objectIndex := range wave {
+				obj := ex.objects[objectIndex]
+				if obj.Weight > 0 && objectIndex != selectedObject {
+					continue
+				}
+				labels := map[string]string{
+					"kube-burner-uuid":                 ex.uuid,
+					"kube-burner-job":                  ex.Name,
+					"kube-burner-index":                strconv.Itoa(objectIndex),
+					"kube-burner-runid":                ex.runid,
+					config.KubeBurnerLabelJobIteration: strconv.Itoa(i),
+				}
+				ex.objects[objectIndex].LabelSelector = labels
+				if obj.RunOnce {
+					if i == 0 {
+						// this executes only once during the first iteration of an object
+						log.Debugf("RunOnce set to %s, so creating object once", obj.ObjectTemplate)
+						ex.replicaHandler(ctx, labels, obj, ns, i, &wg)
+					}
+				} else {
 					ex.replicaHandler(ctx, labels, obj, ns, i, &wg)
 				}
-			} else {
-				ex.replicaHandler(ctx, labels, obj, ns, i, &wg)
+			}
+			// Objects from the next wave are created once the creation requests of this wave are completed
+			if waveIndex < len(ex.createWaves)-1 {
+				log.Debugf("Waiting for creation wave %d of iteration %d to be completed", waveIndex+1, i)
+				wg.Wait()
 			}
 		}
 		if !ex.WaitWhenFinished && ex.PodWait {
@@ -162,6 +249,7 @@ func (ex *Executor) RunCreateJob(ctx context.Context, iterationStart, iterationE
 			time.Sleep(ex.JobIterationDelay)
 		}
 	}
+	ex.checkpoint.startIteration(iterationEnd)
 	// Wait for all replicas to be created
 	wg.Wait()
 	if ex.WaitWhenFinished {
@@ -192,6 +280,85 @@ func (ex *Executor) RunCreateJob(ctx context.Context, iterationStart, iterationE
 	}
 }
 
+// namespaceBatchCompleted returns true when the given number of created namespaces completes a namespace batch
+func (ex *Executor) namespaceBatchCompleted(namespaces int) bool {
+	return ex.NamespaceBatchSize > 0 && ex.NamespaceBatchPause > 0 && namespaces > 0 && namespaces%ex.NamespaceBatchSize == 0
+}
+
+// churnIterations returns the job iterations to churn in the given cycle according to the churn deletion strategy
+func (ex *Executor) churnIterations(cycle, numToChurn int) []int {
+	var iterations []int
+	// Max amount of churn is 100% of namespaces
+	numToChurn = min(numToChurn, ex.JobIterations)
+	switch ex.ChurnDeletionStrategy {
+	case config.ChurnDeletionFIFO:
+		// The oldest iterations are the ones following the iterations churned in the previous cycle
+		start := (cycle * numToChurn) % ex.JobIterations
+		for i := range numToChurn {
+			iterations = append(iterations, (start+i)%ex.JobIterations)
+		}
+		slices.Sort(iterations)
+	case config.ChurnDeletionRandom:
+		iterations = rand.Perm(ex.JobIterations)[:numToChurn]
+		slices.Sort(iterations)
+	default:
+		randStart := rand.Intn(ex.JobIterations - numToChurn + 1)
+		for i := randStart; i < numToChurn+randStart; i++ {
+			iterations = append(iterations, i)
+		}
+	}
+	return iterations
+}
+
+// namespaceIterations returns the sorted iterations of the namespaces holding the given iterations, as churning by namespace
+// deletes all the iterations of a namespace they all have to be re-created
+func (ex *Executor) namespaceIterations(iterations []int) []int {
+	var nsIterations []int
+	for _, i := range iterations {
+		nsStart := i - i%ex.IterationsPerNamespace
+		if len(nsIterations) > 0 && nsIterations[len(nsIterations)-1] >= nsStart {
+			continue
+		}
+		for j := nsStart; j < min(nsStart+ex.IterationsPerNamespace, ex.JobIterations); j++ {
+			nsIterations = append(nsIterations, j)
+		}
+	}
+	return nsIterations
+}
+
+// toIterationRanges groups the given sorted iterations into ranges of consecutive iterations, the end of each range is exclusive
+func toIterationRanges(iterations []int) [][2]int {
+	var ranges [][2]int
+	for _, i := range iterations {
+		if len(ranges) > 0 && ranges[len(ranges)-1][1] == i {
+			ranges[len(ranges)-1][1] = i + 1
+			continue
+		}
+		ranges = append(ranges, [2]int{i, i + 1})
+	}
+	return ranges
+}
+
+// shouldRecreate returns false when the object doesn't match the re-creation selector set by the label churn deletion strategy
+func (ex *Executor) shouldRecreate(obj *unstructured.Unstructured) bool {
+	return ex.recreateSelector == nil || ex.recreateSelector.Matches(labels.Set(obj.GetLabels()))
+}
+
+// cleanupLabeledObjects deletes the objects of the given iterations matching the churn deletion label selector
+func (ex *Executor) cleanupLabeledObjects(ctx context.Context, iterations []int) {
+	for _, i := range iterations {
+		labelSelector := labels.Set{}
+		maps.Copy(labelSelector, ex.ChurnDeletionLabelSelector)
+		labelSelector["kube-burner-job"] = ex.Name
+		labelSelector[config.KubeBurnerLabelJobIteration] = strconv.Itoa(i)
+		namespace := ex.generateNamespace(i)
+		for _, obj := range ex.objects {
+			CleanupNamespaceResourcesUsingGVR(ctx, *ex, obj, namespace, labelSelector.String())
+		}
+		waitForDeleteNamespacedResources(ctx, *ex, namespace, ex.objects, labelSelector.String())
+	}
+}
+
 // Simple integer division on the iteration allows us to batch iterations into
 // namespaces. Division means namespaces are populated to their desired number
 // of iterations before the next namespace is created.
@@ -204,9 +371,14 @@ func (ex *Executor) replicaHandler(ctx context.Context, labels map[string]string
 	var wg sync.WaitGroup
 
 	for r := 1; r <= obj.Replicas; r++ {
+		// The time paused isn't accounted as inter-arrival time
+		if creationPause.wait(ctx) {
+			ex.jitter.reset()
+		}
 		if ctx.Err() != nil {
 			return
 		}
+		ex.jitter.wait(ctx, ex.stats)
 		// make a copy of the labels map for each goroutine to prevent panic from concurrent read and write
 		copiedLabels := make(map[string]string)
 		maps.Copy(copiedLabels, labels)
@@ -216,14 +388,40 @@ func (ex *Executor) replicaHandler(ctx context.Context, labels map[string]string
 		go func(r int) {
 			defer wg.Done()
 			var newObject = new(unstructured.Unstructured)
+			// The object limiter is waited first, so the replicas throttled by it don't hold the job limiter tokens
+			if obj.limiter != nil {
+				obj.limiter.Wait(context.TODO())
+			}
 			ex.limiter.Wait(context.TODO())
 			renderedObj := ex.renderTemplateForObject(obj, iteration, r, false)
 			// Re-decode rendered object
 			yamlToUnstructured(obj.ObjectTemplate, renderedObj, newObject)
 
+			if !ex.shouldRecreate(newObject) {
+				return
+			}
 			maps.Copy(copiedLabels, newObject.GetLabels())
 			newObject.SetLabels(copiedLabels)
 			setMetadataLabels(newObject, copiedLabels)
+			if ex.affinity != nil && mergeAffinity(newObject, ex.affinity) {
+				obj.affinityOverridden.Do(func() {
+					log.Warnf("Job %s: the required nodeAffinity of the job overrides the one of %s, job affinity takes precedence", ex.Name, obj.ObjectTemplate)
+				})
+			}
+			if len(ex.NodeWeights) > 0 {
+				setNodePoolAffinity(newObject, ex.weightedNodePool(obj, iteration, r))
+			}
+			if ex.PriorityClassName != "" {
+				setPriorityClassName(newObject, ex.PriorityClassName)
+			}
+			if ex.dnsProbe != nil {
+				setDNSProbe(newObject, ex.dnsProbe)
+			}
+			// The objects are tracked by their labels, so the name assigned by the API server isn't required afterwards
+			if obj.GenerateName && newObject.GetName() != "" {
+				newObject.SetGenerateName(newObject.GetName() + "-")
+				newObject.SetName("")
+			}
 
 			// replicaWg is necessary because we want to wait for all replicas
 			// to be created before running any other action such as verify objects,
@@ -231,11 +429,16 @@ func (ex *Executor) replicaHandler(ctx context.Context, labels map[string]string
 			// verify objects can lead into a race condition when some objects
 			// hasn't been created yet
 			replicaWg.Add(1)
+			ex.checkpoint.addRequest(iteration)
 			go func(n string) {
 				if !obj.namespaced {
 					n = ""
 				}
 				ex.createRequest(ctx, obj.gvr, n, newObject, ex.MaxWaitTimeout)
+				// Requests interrupted by the benchmark cancellation are retried when resuming
+				if ctx.Err() == nil {
+					ex.checkpoint.doneRequest(iteration)
+				}
 				replicaWg.Done()
 			}(ns)
 		}(r)
@@ -246,7 +449,9 @@ func (ex *Executor) replicaHandler(ctx context.Context, labels map[string]string
 func (ex *Executor) createRequest(ctx context.Context, gvr schema.GroupVersionResource, ns string, obj *unstructured.Unstructured, timeout time.Duration) {
 	var uns *unstructured.Unstructured
 	var err error
-	util.RetryWithExponentialBackOff(func() (bool, error) {
+	var attempts int
+	createFn := func() (bool, error) {
+		attempts++
 		if ctx.Err() != nil {
 			return true, err
 		}
@@ -254,7 +459,9 @@ func (ex *Executor) createRequest(ctx context.Context, gvr schema.GroupVersionRe
 		if objNs := obj.GetNamespace(); objNs != "" {
 			ns = objNs
 		}
-		if ns != "" {
+		if ex.ServerSideApply {
+			uns, err = ex.applyRequest(gvr, ns, obj)
+		} else if ns != "" {
 			uns, err = ex.dynamicClient.Resource(gvr).Namespace(ns).Create(context.TODO(), obj, metav1.CreateOptions{})
 		} else {
 			uns, err = ex.dynamicClient.Resource(gvr).Create(context.TODO(), obj, metav1.CreateOptions{})
@@ -263,7 +470,17 @@ func (ex *Executor) createRequest(ctx context.Context, gvr schema.GroupVersionRe
 			if kerrors.IsUnauthorized(err) {
 				log.Fatalf("Authorization error creating %s/%s: %s", obj.GetKind(), obj.GetName(), err)
 				return true, err
+			} else if ex.ServerSideApply && kerrors.IsConflict(err) {
+				// Conflicts aren't retried, as they persist until the ownership of the fields is forced
+				ex.stats.applyConflicts.Add(1)
+				log.Errorf("Conflict applying %s/%s: %v", obj.GetKind(), obj.GetName(), err)
+				return true, nil
 			} else if kerrors.IsAlreadyExists(err) {
+				// The API server doesn't retry the generated name on collisions
+				if obj.GetName() == "" && obj.GetGenerateName() != "" {
+					log.Warnf("Generated name of %s/%s already exists, retrying", obj.GetKind(), obj.GetGenerateName())
+					return false, nil
+				}
 				if ns != "" {
 					log.Errorf("%s/%s in namespace %s already exists", obj.GetKind(), obj.GetName(), ns)
 				} else {
@@ -288,7 +505,43 @@ func (ex *Executor) createRequest(ctx context.Context, gvr schema.GroupVersionRe
 			log.Debugf("Created %s/%s", uns.GetKind(), uns.GetName())
 		}
 		return true, err
-	}, 1*time.Second, 3, 0, timeout)
+	}
+	if ex.MaxRetries > 0 {
+		err = wait.ExponentialBackoff(wait.Backoff{
+			Duration: ex.RetryBackoff,
+			Factor:   ex.RetryBackoffFactor,
+			Steps:    ex.MaxRetries + 1,
+		}, createFn)
+	} else {
+		err = util.RetryWithExponentialBackOff(createFn, ex.RetryBackoff, ex.RetryBackoffFactor, 0, timeout)
+	}
+	if attempts > 1 {
+		ex.stats.retriedCreations.Add(1)
+	}
+	if err != nil && ctx.Err() == nil {
+		ex.stats.failedCreations.Add(1)
+		log.Errorf("Giving up creating %s/%s after %d attempts: %v", obj.GetKind(), obj.GetName(), attempts, err)
+	}
+}
+
+// applyRequest sends a server-side apply request of the given object, using the configured field manager
+func (ex *Executor) applyRequest(gvr schema.GroupVersionResource, ns string, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	fieldManager := ex.FieldManager
+	if fieldManager == "" {
+		fieldManager = defaultFieldManager
+	}
+	patchOptions := metav1.PatchOptions{
+		FieldManager: fieldManager,
+		Force:        ptr.To(ex.ForceConflicts),
+	}
+	if ns != "" {
+		return ex.dynamicClient.Resource(gvr).Namespace(ns).Patch(context.TODO(), obj.GetName(), types.ApplyPatchType, data, patchOptions)
+	}
+	return ex.dynamicClient.Resource(gvr).Patch(context.TODO(), obj.GetName(), types.ApplyPatchType, data, patchOptions)
 }
 
 // RunCreateJobWithChurn executes a churn creation job
@@ -300,70 +553,88 @@ func (ex *Executor) RunCreateJobWithChurn(ctx context.Context) {
 		log.Info("No namespaces were created in this job, skipping churning stage")
 		return
 	}
+	if ex.ChurnMode == config.ChurnModeSteadyState {
+		ex.runSteadyStateChurn(ctx)
+		return
+	}
 	var err error
 	// Determine the number of job iterations to churn (min 1)
 	numToChurn := int(math.Max(float64(ex.ChurnPercent*ex.JobIterations/100), 1))
 	now := time.Now().UTC()
 	cyclesCount := 0
 	rand.NewSource(now.UnixNano())
-	// Create timer for the churn duration
-	timer := time.After(ex.ChurnDuration)
+	// The time paused isn't accounted in the churn duration
+	pausedAtStart := creationPause.pausedTime()
 	// Patch to label namespaces for deletion
 	delPatch := []byte(`[{"op":"add","path":"/metadata/labels/churndelete","value": "delete"}]`)
 	for {
-		select {
-		case <-timer:
+		creationPause.wait(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(now)-(creationPause.pausedTime()-pausedAtStart) >= ex.ChurnDuration {
 			log.Info("Churn job complete")
 			return
-		default:
-			log.Debugf("Next churn loop, workload churning started %v ago", time.Since(now))
 		}
+		log.Debugf("Next churn loop, workload churning started %v ago", time.Since(now))
 		// Exit if churn cycles are completed
 		if ex.ChurnCycles > 0 && cyclesCount >= ex.ChurnCycles {
 			log.Infof("Reached specified number of churn cycles (%d), stopping churn job", ex.ChurnCycles)
 			return
 		}
-		// Max amount of churn is 100% of namespaces
-		randStart := 1
-		if ex.JobIterations-numToChurn+1 > 0 {
-			randStart = rand.Intn(ex.JobIterations - numToChurn + 1)
-		} else {
-			numToChurn = ex.JobIterations
-		}
-		var namespacesPatched = make(map[string]bool)
+		iterations := ex.churnIterations(cyclesCount, numToChurn)
 		var namespacesToDelete []string
-		// delete numToChurn namespaces starting at randStart
-		for i := randStart; i < numToChurn+randStart; i++ {
-			ns := ex.generateNamespace(i)
-			if namespacesPatched[ns] {
-				continue
+		for _, i := range iterations {
+			if ns := ex.generateNamespace(i); !slices.Contains(namespacesToDelete, ns) {
+				namespacesToDelete = append(namespacesToDelete, ns)
 			}
-			// Label namespaces to be deleted
-			_, err = ex.clientSet.CoreV1().Namespaces().Patch(context.TODO(), ns, types.JSONPatchType, delPatch, metav1.PatchOptions{})
-			if err != nil {
-				log.Errorf("Error patching namespace %s. Error: %v", ns, err)
-			}
-			namespacesPatched[ns] = true
-			namespacesToDelete = append(namespacesToDelete, ns)
 		}
+		// Only the picked iterations are deleted when churning by label or when all the iterations share a namespace
+		namespaceChurn := ex.ChurnDeletionStrategy != config.ChurnDeletionLabel && (ex.JobIterations >= ex.IterationsPerNamespace || len(namespacesToDelete) > 1)
+		if namespaceChurn && ex.IterationsPerNamespace > 1 {
+			iterations = ex.namespaceIterations(iterations)
+		}
+		iterationRanges := toIterationRanges(iterations)
+		log.Infof("Churn cycle %d: churning %d iterations using the %s deletion strategy", cyclesCount+1, len(iterations), ex.ChurnDeletionStrategy)
 		// 1 hour timeout to delete namespaces
-		ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), time.Hour)
 		defer cancel()
-		// Cleanup namespaces based on the labels we added
-		if ex.JobIterations < ex.IterationsPerNamespace && len(namespacesToDelete) == 1 {
-			log.Infof("Churning through iterations: %d to %d in namespace: %s", randStart, numToChurn+randStart, namespacesToDelete[0])
-			CleanupIterations(ctx, *ex, randStart, numToChurn+randStart, namespacesToDelete[0])
-		} else {
-			if ex.ChurnDeletionStrategy == "gvr" {
-				CleanupNamespacesUsingGVR(ctx, *ex, namespacesToDelete)
+		switch {
+		case ex.ChurnDeletionStrategy == config.ChurnDeletionLabel:
+			ex.cleanupLabeledObjects(cleanupCtx, iterations)
+		case !namespaceChurn:
+			for _, r := range iterationRanges {
+				log.Infof("Churning through iterations: %d to %d in namespace: %s", r[0], r[1], namespacesToDelete[0])
+				CleanupIterations(cleanupCtx, *ex, r[0], r[1], namespacesToDelete[0])
+			}
+		default:
+			for _, ns := range namespacesToDelete {
+				// Label namespaces to be deleted
+				_, err = ex.clientSet.CoreV1().Namespaces().Patch(context.TODO(), ns, types.JSONPatchType, delPatch, metav1.PatchOptions{})
+				if err != nil {
+					log.Errorf("Error patching namespace %s. Error: %v", ns, err)
+				}
 			}
-			util.CleanupNamespaces(ctx, ex.clientSet, "churndelete=delete")
+			if ex.ChurnDeletionStrategy == config.ChurnDeletionGVR {
+				CleanupNamespacesUsingGVR(cleanupCtx, *ex, namespacesToDelete)
+			}
+			// Cleanup namespaces based on the labels we added
+			util.CleanupNamespaces(cleanupCtx, ex.clientSet, "churndelete=delete")
 		}
 		log.Info("Re-creating deleted objects")
+		if ex.ChurnDeletionStrategy == config.ChurnDeletionLabel {
+			// Only the deleted objects have to be re-created
+			ex.recreateSelector = labels.SelectorFromSet(ex.ChurnDeletionLabelSelector)
+		}
 		// Re-create objects that were deleted
-		ex.RunCreateJob(ctx, randStart, numToChurn+randStart, &[]string{})
+		for _, r := range iterationRanges {
+			ex.RunCreateJob(cleanupCtx, r[0], r[1], &[]string{})
+		}
+		ex.recreateSelector = nil
 		log.Infof("Sleeping for %v", ex.ChurnDelay)
-		time.Sleep(ex.ChurnDelay)
+		if !creationPause.sleep(ctx, ex.ChurnDelay) {
+			return
+		}
 		cyclesCount++
 	}
 }
@@ -0,0 +1,46 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+
+	mmetrics "github.com/kube-burner/kube-burner/pkg/measurements/metrics"
+	log "github.com/sirupsen/logrus"
+)
+
+var csvSummaryHeader = []string{"jobName", "measurement", "metric", "quantile", "value"}
+
+// writeCSVSummary writes the measurement latency quantiles of the benchmark into a CSV file, one row per quantile
+func writeCSVSummary(uuid string, quantiles []mmetrics.LatencyQuantiles) error {
+	fileName := fmt.Sprintf("kube-burner-summary-%s.csv", uuid)
+	f, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := csv.NewWriter(f)
+	records := [][]string{csvSummaryHeader}
+	for _, q := range quantiles {
+		records = append(records, q.CSVRecords()...)
+	}
+	if err := w.WriteAll(records); err != nil {
+		return err
+	}
+	log.Infof("Measurement summary written to %s", fileName)
+	return nil
+}
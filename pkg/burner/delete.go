@@ -16,16 +16,22 @@ package burner
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/kube-burner/kube-burner/pkg/config"
 	log "github.com/sirupsen/logrus"
+	policyv1 "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
 )
 
 func (ex *Executor) setupDeleteJob(mapper meta.RESTMapper) {
@@ -41,7 +47,7 @@ func (ex *Executor) setupDeleteJob(mapper meta.RESTMapper) {
 	// WaitWhenFinished expects the resources to exists. For this reason wait is handled by WaitForDeletion
 	ex.WaitWhenFinished = false
 	for _, o := range ex.Objects {
-		log.Debugf("Job %s: %s %s with selector %s", ex.Name, ex.JobType, o.Kind, labels.Set(o.LabelSelector))
+		log.Debugf("Job %s: %s %s with selector %s and field selector %s", ex.Name, ex.JobType, o.Kind, labels.Set(o.LabelSelector), fields.Set(o.FieldSelector))
 		ex.objects = append(ex.objects, newObject(o, mapper, APIVersionV1, ex.embedCfg))
 	}
 }
@@ -50,22 +56,79 @@ func deleteHandler(ex *Executor, obj *object, item unstructured.Unstructured, it
 	defer wg.Done()
 	ex.limiter.Wait(context.TODO())
 	var err error
+	deleteOptions := ex.deleteOptions()
+	if ex.Evict && obj.gvr.Group == "" && obj.gvr.Resource == "pods" {
+		log.Debugf("Evicting %s/%s from namespace %s", item.GetKind(), item.GetName(), item.GetNamespace())
+		if err = ex.evictPod(item, deleteOptions); err != nil {
+			log.Errorf("Error found evicting %s/%s: %s", item.GetKind(), item.GetName(), err)
+		}
+		return
+	}
 	if obj.namespaced {
 		log.Debugf("Removing %s/%s from namespace %s", item.GetKind(), item.GetName(), item.GetNamespace())
-		err = ex.dynamicClient.Resource(obj.gvr).Namespace(item.GetNamespace()).Delete(context.TODO(), item.GetName(), metav1.DeleteOptions{})
+		err = ex.dynamicClient.Resource(obj.gvr).Namespace(item.GetNamespace()).Delete(context.TODO(), item.GetName(), deleteOptions)
 	} else {
 		log.Debugf("Removing %s/%s", item.GetKind(), item.GetName())
-		err = ex.dynamicClient.Resource(obj.gvr).Delete(context.TODO(), item.GetName(), metav1.DeleteOptions{})
+		err = ex.dynamicClient.Resource(obj.gvr).Delete(context.TODO(), item.GetName(), deleteOptions)
 	}
 	if err != nil {
 		log.Errorf("Error found removing %s/%s: %s", item.GetKind(), item.GetName(), err)
 	}
 }
 
+// evictPod evicts the pod through the eviction API, so its PodDisruptionBudgets are honored. The evictions blocked by a budget
+// are retried until the pod is evicted or maxWaitTimeout is reached. The time of the first eviction request is annotated in the pod
+func (ex *Executor) evictPod(item unstructured.Unstructured, deleteOptions metav1.DeleteOptions) error {
+	ns, name := item.GetNamespace(), item.GetName()
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, config.KubeBurnerAnnotationEvictionRequested, time.Now().UTC().Format(time.RFC3339Nano))
+	if _, err := ex.clientSet.CoreV1().Pods(ns).Patch(context.TODO(), name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error annotating the eviction request: %v", err)
+	}
+	eviction := &policyv1.Eviction{
+		ObjectMeta:    metav1.ObjectMeta{Name: name, Namespace: ns},
+		DeleteOptions: &deleteOptions,
+	}
+	var blocked bool
+	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, ex.MaxWaitTimeout, true, func(ctx context.Context) (bool, error) {
+		err := ex.clientSet.PolicyV1().Evictions(ns).Evict(ctx, eviction)
+		switch {
+		case err == nil, kerrors.IsNotFound(err):
+			return true, nil
+		case kerrors.IsTooManyRequests(err):
+			// The eviction would violate a PodDisruptionBudget
+			if !blocked {
+				log.Debugf("Eviction of pod %s/%s blocked by its PodDisruptionBudget: %v", ns, name, err)
+				blocked = true
+			}
+			return false, nil
+		}
+		return false, err
+	})
+	if wait.Interrupted(err) {
+		return fmt.Errorf("eviction blocked by its PodDisruptionBudget after %v", ex.MaxWaitTimeout)
+	}
+	return err
+}
+
+// deleteOptions returns the options of the delete requests according to the gracePeriodSeconds and force settings of the job
+func (ex *Executor) deleteOptions() metav1.DeleteOptions {
+	if ex.Force {
+		return metav1.DeleteOptions{
+			GracePeriodSeconds: ptr.To[int64](0),
+			PropagationPolicy:  ptr.To(metav1.DeletePropagationBackground),
+		}
+	}
+	return metav1.DeleteOptions{GracePeriodSeconds: ex.GracePeriodSeconds}
+}
+
 func verifyDelete(ex *Executor, obj *object) {
 	labelSelector := labels.Set(obj.LabelSelector).String()
 	listOptions := metav1.ListOptions{
 		LabelSelector: labelSelector,
+		FieldSelector: fields.Set(obj.FieldSelector).String(),
 	}
 	wait.PollUntilContextCancel(context.TODO(), 2*time.Second, true, func(ctx context.Context) (done bool, err error) {
 		itemList, err := ex.dynamicClient.Resource(obj.gvr).List(context.TODO(), listOptions)
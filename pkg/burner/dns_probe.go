@@ -0,0 +1,57 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"slices"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// dnsProbe returns the DNS probe injected into the pods of create jobs when the dnsLatency measurement is enabled, nil otherwise
+func dnsProbe(configSpec config.Spec, job config.Job) map[string]any {
+	if job.JobType != config.CreationJob {
+		return nil
+	}
+	for _, measurement := range configSpec.GlobalConfig.Measurements {
+		if measurement.Name != measurements.DNSLatency {
+			continue
+		}
+		probe := measurements.NewDNSProbe(measurement)
+		probeObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&probe)
+		if err != nil {
+			log.Fatalf("Error preparing the DNS probe: %v", err)
+		}
+		return probeObj
+	}
+	return nil
+}
+
+// setDNSProbe prepends the DNS probe to the init containers of the pod spec of the object, so the lookup is timed
+// before any other container runs. Objects without pod spec are left untouched
+func setDNSProbe(obj *unstructured.Unstructured, probe map[string]any) {
+	podSpecPath, ok := kindToPodSpecPath[obj.GetKind()]
+	if !ok {
+		return
+	}
+	initContainersPath := append(slices.Clone(podSpecPath), "initContainers")
+	initContainers, _, _ := unstructured.NestedSlice(obj.Object, initContainersPath...)
+	initContainers = append([]any{runtime.DeepCopyJSON(probe)}, initContainers...)
+	unstructured.SetNestedSlice(obj.Object, initContainers, initContainersPath...)
+}
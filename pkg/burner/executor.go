@@ -16,15 +16,19 @@ package burner
 
 import (
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"maps"
 
 	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/metrics"
 	"github.com/kube-burner/kube-burner/pkg/util"
 	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/time/rate"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -36,6 +40,64 @@ import (
 type ItemHandler func(ex *Executor, obj *object, originalItem unstructured.Unstructured, iteration int, objectTimeUTC int64, wg *sync.WaitGroup)
 type ObjectFinalizer func(ex *Executor, obj *object)
 
+// jobStats holds the request statistics collected during the job execution
+type jobStats struct {
+	// retriedCreations object creations that needed retries
+	retriedCreations atomic.Int64
+	// failedCreations object creations that failed after exhausting the retries
+	failedCreations atomic.Int64
+	// waitSucceededObjects objects satisfying their ready condition within the object wait timeout
+	waitSucceededObjects atomic.Int64
+	// waitTimedOutObjects objects exceeding the object wait timeout
+	waitTimedOutObjects atomic.Int64
+	// applyConflicts server-side apply requests rejected due to field ownership conflicts
+	applyConflicts atomic.Int64
+	mu             sync.Mutex
+	// patchLatencies patch request latencies in milliseconds
+	patchLatencies []float64
+	// qpsSamples QPS set by the QPS ramp-up schedule over time
+	qpsSamples []qpsSample
+	// listSamples results of the LIST calls of list jobs
+	listSamples []listSample
+	// scaleSamples results of the scale operations of scale jobs
+	scaleSamples []scaleSample
+	// interArrivals time between consecutive object creations in milliseconds, recorded when creationJitter is enabled
+	interArrivals []float64
+	// notReadyObjects number of objects not satisfying their ready condition by kind, recorded when verifyReadiness is enabled
+	notReadyObjects map[string]int
+	// templateMix number of iterations each weighted object template was selected in
+	templateMix map[string]int
+	// pausedTime time the object operations were paused during the job
+	pausedTime time.Duration
+	// repetitions number of times the job was run, greater than 1 when repeatUntil is configured
+	repetitions int
+	// skippedObjects templates of the objects not created because of their platform predicates
+	skippedObjects []string
+	// liveObjectsSamples live object count sampled during the steady-state churn
+	liveObjectsSamples []liveObjectsSample
+	// podFaultRecoveries recoveries of the pods deleted by the fault injection
+	podFaultRecoveries []podFaultRecovery
+	// apiRequests API requests issued during the job by verb and resource
+	apiRequests []config.APIRequestCount
+}
+
+func (s *jobStats) addPatchLatency(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.patchLatencies = append(s.patchLatencies, float64(latency.Milliseconds()))
+}
+
+// patchLatencySummary returns the patch latency quantiles, nil when no patch requests were sent
+func (s *jobStats) patchLatencySummary() *metrics.LatencyQuantiles {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.patchLatencies) == 0 {
+		return nil
+	}
+	summary := metrics.NewLatencySummary(s.patchLatencies, "Patch")
+	return &summary
+}
+
 type Executor struct {
 	config.Job
 	objects           []*object
@@ -52,6 +114,27 @@ type Executor struct {
 	kubeVirtClient    kubecli.KubevirtClient
 	functionTemplates []string
 	embedCfg          *fileutils.EmbedConfiguration
+	stats             *jobStats
+	// recreateSelector when set, only the objects matching it are created
+	recreateSelector labels.Selector
+	// createWaves indexes of the objects created in each wave, sorted by createOrder
+	createWaves [][]int
+	// totalWeight sum of the weights of the weighted objects
+	totalWeight int
+	// jitter randomized delay between object creations
+	jitter *creationJitter
+	// envVars host environment variables, exposed to the object templates as .Env
+	envVars map[string]any
+	// clusterMetadata cluster facts fetched at job start, exposed to the object templates
+	clusterMetadata map[string]any
+	// skippedObjects templates of the objects not created because of their platform predicates
+	skippedObjects []string
+	// checkpoint progress of the job creation iterations, nil when checkpointing is disabled
+	checkpoint *jobCheckpoint
+	// dnsProbe init container injected into the created pods by the dnsLatency measurement, nil when it's not enabled
+	dnsProbe map[string]any
+	// affinity job affinity merged into the created pods, nil when not set
+	affinity map[string]any
 }
 
 func newExecutor(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider, job config.Job, embedCfg *fileutils.EmbedConfiguration) Executor {
@@ -63,9 +146,14 @@ func newExecutor(configSpec config.Spec, kubeClientProvider *config.KubeClientPr
 		waitLimiter:       rate.NewLimiter(rate.Limit(job.QPS), job.Burst),
 		functionTemplates: configSpec.GlobalConfig.FunctionTemplates,
 		embedCfg:          embedCfg,
+		stats:             &jobStats{},
+		jitter:            newCreationJitter(job),
+		envVars:           util.EnvToMap(),
+		dnsProbe:          dnsProbe(configSpec, job),
+		affinity:          jobAffinity(job),
 	}
 
-	clientSet, runtimeRestConfig := kubeClientProvider.ClientSet(job.QPS, job.Burst)
+	clientSet, runtimeRestConfig := kubeClientProvider.JobClientSet(job)
 	ex.clientSet = clientSet
 	ex.restConfig = runtimeRestConfig
 	ex.dynamicClient = dynamic.NewForConfigOrDie(ex.restConfig)
@@ -82,8 +170,12 @@ func newExecutor(configSpec config.Spec, kubeClientProvider *config.KubeClientPr
 		ex.setupPatchJob(mapper)
 	case config.ReadJob:
 		ex.setupReadJob(mapper)
+	case config.ListJob:
+		ex.setupListJob(mapper)
 	case config.KubeVirtJob:
 		ex.setupKubeVirtJob(mapper)
+	case config.ScaleJob:
+		ex.setupScaleJob(mapper)
 	default:
 		log.Fatalf("Unknown jobType: %s", job.JobType)
 	}
@@ -98,7 +190,13 @@ func (ex *Executor) renderTemplateForObject(obj *object, iteration, replicaIndex
 		jobUUID:      ex.uuid,
 		jobRunId:     ex.runid,
 		replica:      replicaIndex,
+		envVars:      ex.envVars,
+	}
+	// Position of the object among the replicas created by the job from the same template, patch requests have no replica
+	if replicaIndex > 0 {
+		templateData[objectOrdinal] = iteration*obj.Replicas + replicaIndex - 1
 	}
+	maps.Copy(templateData, ex.clusterMetadata)
 	maps.Copy(templateData, obj.InputVars)
 
 	templateOption := util.MissingKeyError
@@ -106,7 +204,7 @@ func (ex *Executor) renderTemplateForObject(obj *object, iteration, replicaIndex
 		templateOption = util.MissingKeyZero
 	}
 
-	renderedObj, err := util.RenderTemplate(obj.objectSpec, templateData, templateOption, ex.functionTemplates)
+	renderedObj, err := util.RenderObjectTemplate(obj.objectSpec, templateData, templateOption, ex.functionTemplates, ex.uuid, obj.fileFuncs)
 	if err != nil {
 		log.Fatalf("Template error in %s: %s", obj.ObjectTemplate, err)
 	}
@@ -0,0 +1,75 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/util"
+	log "github.com/sirupsen/logrus"
+)
+
+// outputDirectory returns the directory kube-burner writes its results in, the one of the first local indexer
+// or else the working directory
+func outputDirectory(configSpec config.Spec) string {
+	for _, metricsEndpoint := range configSpec.MetricsEndpoints {
+		for _, indexer := range append([]config.IndexerConfig{metricsEndpoint.IndexerConfig}, metricsEndpoint.Indexers...) {
+			if indexer.Type == indexers.LocalIndexer {
+				return indexer.MetricsDirectory
+			}
+		}
+	}
+	dir, _ := os.Getwd()
+	return dir
+}
+
+// runHook runs the given hook command through the shell, exposing the run UUID and output directory
+// in its environment along with the given extra variables. Hooks are killed after hookTimeout, unless it's 0
+func runHook(name, hook string, configSpec config.Spec, extraEnv ...string) error {
+	log.Infof("Running %s: %s", name, hook)
+	ctx, cancel := context.WithCancel(context.Background())
+	if configSpec.GlobalConfig.HookTimeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), configSpec.GlobalConfig.HookTimeout)
+	}
+	defer cancel()
+	env := append([]string{
+		"KUBE_BURNER_UUID=" + configSpec.GlobalConfig.UUID,
+		"KUBE_BURNER_OUTPUT_DIR=" + outputDirectory(configSpec),
+	}, extraEnv...)
+	outb, errb, err := util.RunShellCommand(ctx, hook, env)
+	log.Infof("%s out: %v, err: %v", name, outb.String(), errb.String())
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("%s timed out after %v", name, configSpec.GlobalConfig.HookTimeout)
+	}
+	if err != nil {
+		return fmt.Errorf("%s failed: %v", name, err)
+	}
+	return nil
+}
+
+// runPostRunHook runs the post-run hook with the return code of the run, failures are logged as they can't change the run result
+func runPostRunHook(configSpec config.Spec, rc int) {
+	if configSpec.GlobalConfig.PostRunHook == "" {
+		return
+	}
+	if err := runHook("postRunHook", configSpec.GlobalConfig.PostRunHook, configSpec, "KUBE_BURNER_RC="+strconv.Itoa(rc)); err != nil {
+		log.Error(err.Error())
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+)
+
+func TestRunHook(t *testing.T) {
+	output := path.Join(t.TempDir(), "hook.out")
+	configSpec := config.Spec{GlobalConfig: config.GlobalConfig{UUID: "uuid", HookTimeout: time.Minute}}
+	if err := runHook("postRunHook", "echo $KUBE_BURNER_UUID $KUBE_BURNER_RC > "+output, configSpec, "KUBE_BURNER_RC=3"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(string(out)) != "uuid 3" {
+		t.Errorf("unexpected hook environment: %q", out)
+	}
+	if err := runHook("preRunHook", "exit 1", configSpec); err == nil {
+		t.Error("expected error from a failing hook")
+	}
+}
+
+func TestRunHookTimeout(t *testing.T) {
+	configSpec := config.Spec{GlobalConfig: config.GlobalConfig{HookTimeout: 100 * time.Millisecond}}
+	start := time.Now()
+	err := runHook("preRunHook", "sleep 30", configSpec)
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 15*time.Second {
+		t.Errorf("hook wasn't killed on timeout, took %v", elapsed)
+	}
+}
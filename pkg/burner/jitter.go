@@ -0,0 +1,96 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/metrics"
+	log "github.com/sirupsen/logrus"
+)
+
+// creationJitter inserts randomized delays between object creations, it's shared by all the creation goroutines of a job
+type creationJitter struct {
+	mu           sync.Mutex
+	rng          *rand.Rand
+	min          time.Duration
+	max          time.Duration
+	lastCreation time.Time
+}
+
+// newCreationJitter returns the creation jitter of the job, nil when it's not enabled
+func newCreationJitter(job config.Job) *creationJitter {
+	if job.CreationJitter.Max <= 0 {
+		return nil
+	}
+	seed := job.CreationJitter.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	log.Infof("Job %s: creation jitter between %v and %v, seed: %d", job.Name, job.CreationJitter.Min, job.CreationJitter.Max, seed)
+	return &creationJitter{
+		rng: rand.New(rand.NewSource(seed)),
+		min: job.CreationJitter.Min,
+		max: job.CreationJitter.Max,
+	}
+}
+
+// wait sleeps a random delay and records the time elapsed since the previous creation. Delays are drawn
+// and slept while holding the lock, so creations are serialized and the sequence of delays is reproducible
+func (cj *creationJitter) wait(ctx context.Context, stats *jobStats) {
+	if cj == nil {
+		return
+	}
+	cj.mu.Lock()
+	defer cj.mu.Unlock()
+	delay := cj.min + time.Duration(cj.rng.Int63n(int64(cj.max-cj.min)+1))
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(delay):
+	}
+	now := time.Now()
+	if !cj.lastCreation.IsZero() {
+		stats.mu.Lock()
+		stats.interArrivals = append(stats.interArrivals, float64(now.Sub(cj.lastCreation).Milliseconds()))
+		stats.mu.Unlock()
+	}
+	cj.lastCreation = now
+}
+
+// reset discards the time of the previous creation, so the next inter-arrival time isn't recorded
+func (cj *creationJitter) reset() {
+	if cj == nil {
+		return
+	}
+	cj.mu.Lock()
+	defer cj.mu.Unlock()
+	cj.lastCreation = time.Time{}
+}
+
+// interArrivalSummary returns the quantiles of the time between object creations, nil when creation jitter isn't enabled
+func (s *jobStats) interArrivalSummary() *metrics.LatencyQuantiles {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.interArrivals) == 0 {
+		return nil
+	}
+	summary := metrics.NewLatencySummary(s.interArrivals, "InterArrival")
+	return &summary
+}
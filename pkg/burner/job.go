@@ -15,18 +15,23 @@
 package burner
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"os/signal"
 	"slices"
 	"strconv"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/cloud-bulldozer/go-commons/v2/indexers"
 	"github.com/cloud-bulldozer/go-commons/v2/version"
 	"github.com/kube-burner/kube-burner/pkg/config"
 	"github.com/kube-burner/kube-burner/pkg/measurements"
+	mmetrics "github.com/kube-burner/kube-burner/pkg/measurements/metrics"
 	"github.com/kube-burner/kube-burner/pkg/prometheus"
 	"github.com/kube-burner/kube-burner/pkg/util"
 	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
@@ -40,20 +45,40 @@ import (
 
 // returnPair is a pair of return codes for a job
 type returnPair struct {
-	innerRC         int
-	executionErrors string
+	innerRC          int
+	executionErrors  string
+	retriedCreations int64
+	failedCreations  int64
+	patchLatency     *mmetrics.LatencyQuantiles
+	interArrival     *mmetrics.LatencyQuantiles
+	notReadyObjects  map[string]int
+	repetitions      int
+	waitSucceeded    int64
+	waitTimedOut     int64
+	applyConflicts   int64
+	templateMix      map[string]int
+	pausedTime       time.Duration
+	skippedObjects   []string
+	apiRequests      []config.APIRequestCount
 }
 
 const (
 	jobName              = "JobName"
 	replica              = "Replica"
+	objectOrdinal        = "Ordinal"
 	jobIteration         = "Iteration"
 	jobUUID              = "UUID"
 	jobRunId             = "RunID"
+	envVars              = "Env"
 	rcTimeout            = 2
 	rcAlert              = 3
 	rcMeasurement        = 4
+	rcNotReady           = 5
+	rcAlertAbort         = 6
+	rcPartialFailure     = 7
+	rcInterrupted        = 8
 	garbageCollectionJob = "garbage-collection"
+	defaultFieldManager  = "kube-burner"
 	APIVersionV1         = "v1"
 )
 
@@ -85,24 +110,94 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 	globalWaitMap := make(map[string][]string)
 	executorMap := make(map[string]Executor)
 	returnMap := make(map[string]returnPair)
+	jobStatsMap := make(map[string]*jobStats)
 	timeoutGCStarted := false
 	log.Infof("🔥 Starting kube-burner (%s@%s) with UUID %s", version.Version, version.GitCommit, uuid)
+	// The post-run hook runs once the run is over, whatever its result
+	defer func() { runPostRunHook(configSpec, rc) }()
+	if globalConfig.PreRunHook != "" {
+		if err := runHook("preRunHook", globalConfig.PreRunHook, configSpec); err != nil {
+			rc = 1
+			return rc, err
+		}
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), configSpec.GlobalConfig.Timeout)
 	defer cancel()
+	// Runs cordoning nodes are aborted on SIGINT or SIGTERM, so the nodes are uncordoned once the run is over.
+	// A second signal terminates kube-burner right away
+	interruptCh := make(chan os.Signal, 1)
+	if globalConfig.Cordon.Enabled() {
+		clientSet, _ := kubeClientProvider.DefaultClientSet()
+		uncordon, err := cordonNodes(clientSet, globalConfig.Cordon, uuid)
+		if err != nil {
+			rc = 1
+			return rc, err
+		}
+		defer uncordon()
+		signal.Notify(interruptCh, os.Interrupt, syscall.SIGTERM)
+		defer signal.Stop(interruptCh)
+	}
+	var cp *checkpoint
+	stopCheckpoint := func() {}
+	if globalConfig.CheckpointInterval > 0 || globalConfig.Resume {
+		if cp, err = newCheckpoint(uuid, configSpec.GlobalConfig.RUNID, globalConfig.Resume); err != nil {
+			rc = 1
+			return rc, err
+		}
+		// The objects created before resuming are labeled with the runid of the checkpoint
+		configSpec.GlobalConfig.RUNID = cp.runid
+		globalConfig.RUNID = cp.runid
+		stopCheckpoint = cp.start(cmp.Or(globalConfig.CheckpointInterval, defaultCheckpointInterval))
+		defer stopCheckpoint()
+	}
+	abortCh := make(chan string, 1)
+	alertsCtx, stopAlerts := context.WithCancel(ctx)
+	if globalConfig.AlertAbort.Interval > 0 {
+		go watchAlerts(alertsCtx, cancel, metricsScraper.AlertMs, globalConfig.AlertAbort, abortCh)
+	}
+	watchPauseSignals(ctx, creationPause)
+	preLoadErrCh := make(chan error, 1)
+	var preLoadWg sync.WaitGroup
+	preLoadWg.Add(1)
 	go func() {
 		var innerRC int
 		clientSet, _ := kubeClientProvider.DefaultClientSet()
 		measurementsFactory := measurements.NewMeasurementsFactory(configSpec, metricsScraper.MetricsMetadata, additionalMeasurementFactoryMap)
 		jobList = newExecutorList(configSpec, kubeClientProvider, embedCfg)
-		handlePreloadImages(jobList, kubeClientProvider)
+		err := handlePreloadImages(ctx, jobList, kubeClientProvider, uuid, metricsScraper)
+		preLoadWg.Done()
+		if err != nil {
+			// The abort of a run already canceled is handled by Run
+			if ctx.Err() != nil {
+				log.Error(err.Error())
+			} else {
+				preLoadErrCh <- err
+			}
+			return
+		}
 		// Iterate job list
 		var measurementsInstance *measurements.Measurements
+		var measurementQuantiles []mmetrics.LatencyQuantiles
 		var measurementsJobName string
 		for jobPosition, job := range jobList {
+			jobCp := cp.job(job.Name)
+			if jobCp.completed() {
+				log.Infof("Job %s already completed, skipping it", job.Name)
+				continue
+			}
+			job.clusterMetadata = getClusterMetadata(clientSet)
+			if job.WarmupIterations > 0 {
+				job.runWarmup(ctx)
+				if ctx.Err() != nil {
+					return
+				}
+			}
 			executedJobs = append(executedJobs, prometheus.Job{
 				Start:     time.Now().UTC(),
 				JobConfig: job.Job,
 			})
+			pausedAtStart := creationPause.pausedTime()
+			apiRequestsAtStart := kubeClientProvider.APIRequests()
 			watcherManager := watchers.NewWatcherManager(clientSet, rate.NewLimiter(rate.Limit(job.QPS), job.Burst))
 			for idx, watcher := range job.Watchers {
 				for replica := range watcher.Replicas {
@@ -110,7 +205,7 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 				}
 			}
 			watcherStartErrors := watcherManager.Wait()
-			slices.Concat(errs, watcherStartErrors)
+			errs = slices.Concat(errs, watcherStartErrors)
 			var waitListNamespaces []string
 			if measurementsInstance == nil {
 				measurementsJobName = job.Name
@@ -118,8 +213,11 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 				measurementsInstance.Start()
 			}
 			log.Infof("Triggering job: %s", job.Name)
+			stopQPSRamp := job.startQPSRamp(ctx)
+			stopPodFaults := func() {}
 			if job.JobType == config.CreationJob {
-				if job.Cleanup {
+				// The objects created before resuming are kept, only the job resumed from its checkpoint has them
+				if job.Cleanup && jobCp.resumeIteration() == 0 {
 					// No timeout for initial job cleanup
 					garbageCollectJob(context.TODO(), job, fmt.Sprintf("kube-burner-job=%s", job.Name), nil)
 				}
@@ -130,13 +228,36 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 					log.Infof("Churn percent: %v", job.ChurnPercent)
 					log.Infof("Churn delay: %v", job.ChurnDelay)
 					log.Infof("Churn deletion strategy: %v", job.ChurnDeletionStrategy)
+					log.Infof("Churn mode: %v", job.ChurnMode)
 				}
-				job.RunCreateJob(ctx, 0, job.JobIterations, &waitListNamespaces)
+				jobIterations := job.JobIterations
+				resumeIteration := jobCp.resumeIteration()
+				job.checkpoint = jobCp
+				job.stats.repetitions = job.runRepeated(ctx, metricsScraper.PrometheusClients, func(repetition int) {
+					// Each repetition creates a new set of iterations, the ones created before resuming are skipped
+					iterationStart, iterationEnd := repetitionIterations(repetition, jobIterations, resumeIteration)
+					job.RunCreateJob(ctx, iterationStart, iterationEnd, &waitListNamespaces)
+				})
+				// The iterations re-created by churn aren't checkpointed
+				job.checkpoint = nil
+				job.stats.templateMix = job.templateMix()
+				job.stats.skippedObjects = job.skippedObjects
 				if ctx.Err() != nil {
 					return
 				}
+				if err := job.waitTimeoutsError(); err != nil {
+					log.Error(err.Error())
+					errs = append(errs, err)
+					// A partial failure doesn't override the return code of a previous failure
+					if rc := job.waitTimeoutsRC(); rc != rcPartialFailure || innerRC == 0 {
+						innerRC = rc
+					}
+				}
 				// If object verification is enabled
-				if job.VerifyObjects && !job.Verify() {
+				// The objects from all the repetitions are accounted by the verification, the job config is shared with the measurements
+				verifyJob := job
+				verifyJob.JobIterations = jobIterations * job.stats.repetitions
+				if job.VerifyObjects && !verifyJob.Verify() {
 					err := errors.New("object verification failed")
 					// If errorOnVerify is enabled. Set RC to 1 and append error
 					if job.ErrorOnVerify {
@@ -145,6 +266,17 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 					}
 					log.Error(err.Error())
 				}
+				if job.VerifyReadiness {
+					job.stats.notReadyObjects = job.verifyReadiness()
+					if len(job.stats.notReadyObjects) > 0 {
+						err := fmt.Errorf("readiness verification failed, objects not ready: %v", job.stats.notReadyObjects)
+						log.Error(err.Error())
+						errs = append(errs, err)
+						innerRC = rcNotReady
+					}
+				}
+				// Faults are injected once the objects are created, until the job finishes
+				stopPodFaults = job.startPodFaults(ctx)
 				if job.Churn {
 					churnStart := time.Now().UTC()
 					executedJobs[len(executedJobs)-1].ChurnStart = &churnStart
@@ -152,14 +284,58 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 					churnEnd := time.Now().UTC()
 					executedJobs[len(executedJobs)-1].ChurnEnd = &churnEnd
 				}
+				if ia := job.stats.interArrivalSummary(); ia != nil {
+					log.Infof("%s: creation %s 50th: %dms 99th: %dms min: %dms max: %dms avg: %dms", job.Name, ia.QuantileName, ia.P50, ia.P99, ia.Min, ia.Max, ia.Avg)
+				}
 				globalWaitMap[strconv.Itoa(jobPosition)+job.Name] = waitListNamespaces
 				executorMap[strconv.Itoa(jobPosition)+job.Name] = job
 			} else {
-				job.RunJob(ctx)
+				job.stats.repetitions = job.runRepeated(ctx, metricsScraper.PrometheusClients, func(int) {
+					job.RunJob(ctx)
+				})
 				if ctx.Err() != nil {
 					return
 				}
+				if err := job.waitTimeoutsError(); err != nil {
+					log.Error(err.Error())
+					errs = append(errs, err)
+					// A partial failure doesn't override the return code of a previous failure
+					if rc := job.waitTimeoutsRC(); rc != rcPartialFailure || innerRC == 0 {
+						innerRC = rc
+					}
+				}
+				if pq := job.stats.patchLatencySummary(); pq != nil {
+					log.Infof("%s: %s 50th: %d 99th: %d max: %d avg: %d", job.Name, pq.QuantileName, pq.P50, pq.P99, pq.Max, pq.Avg)
+				}
+				for _, lq := range job.stats.listLatencySummary() {
+					log.Infof("%s: %s 50th: %d 99th: %d max: %d avg: %d", job.Name, lq.QuantileName, lq.P50, lq.P99, lq.Max, lq.Avg)
+				}
+				if !job.SkipIndexing && len(job.stats.listSamples) > 0 {
+					for _, indexer := range metricsScraper.IndexerList {
+						IndexListSamples(uuid, job.Name, job.stats, metricsScraper.SummaryMetadata, indexer)
+					}
+				}
+				for _, sq := range job.stats.scaleLatencySummary() {
+					log.Infof("%s: %s 50th: %d 99th: %d max: %d avg: %d", job.Name, sq.QuantileName, sq.P50, sq.P99, sq.Max, sq.Avg)
+				}
+				if !job.SkipIndexing && len(job.stats.scaleSamples) > 0 {
+					for _, indexer := range metricsScraper.IndexerList {
+						IndexScaleSamples(uuid, job.Name, job.stats, metricsScraper.SummaryMetadata, indexer)
+					}
+				}
+			}
+			stopQPSRamp()
+			if !job.SkipIndexing && len(job.stats.qpsSamples) > 0 {
+				for _, indexer := range metricsScraper.IndexerList {
+					IndexQPSSamples(uuid, job.Name, job.stats.qpsSamples, metricsScraper.SummaryMetadata, indexer)
+				}
+			}
+			if !job.SkipIndexing && len(job.stats.liveObjectsSamples) > 0 {
+				for _, indexer := range metricsScraper.IndexerList {
+					IndexLiveObjectsSamples(uuid, job.Name, job.stats.liveObjectsSamples, metricsScraper.SummaryMetadata, indexer)
+				}
 			}
+			jobStatsMap[job.Name] = job.stats
 			if job.BeforeCleanup != "" {
 				log.Infof("Waiting for beforeCleanup command %s to finish", job.BeforeCleanup)
 				stdOut, stdErr, err := util.RunShellCmd(job.BeforeCleanup, job.embedCfg)
@@ -172,6 +348,9 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 				log.Infof("BeforeCleanup out: %v, err: %v", stdOut.String(), stdErr.String())
 			}
 			jobEnd := time.Now().UTC()
+			job.stats.pausedTime = creationPause.pausedTime() - pausedAtStart
+			job.stats.apiRequests = kubeClientProvider.APIRequestsSince(apiRequestsAtStart)
+			logAPIRequests(job.Name, job.stats.apiRequests)
 			if job.MetricsClosing == config.AfterJob {
 				executedJobs[len(executedJobs)-1].End = jobEnd
 			}
@@ -179,6 +358,12 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 				log.Infof("Pausing for %v before finishing job", job.JobPause)
 				time.Sleep(job.JobPause)
 			}
+			stopPodFaults()
+			if !job.SkipIndexing && len(job.stats.podFaultRecoveries) > 0 {
+				for _, indexer := range metricsScraper.IndexerList {
+					IndexPodFaultRecoveries(uuid, job.Name, job.stats, metricsScraper.SummaryMetadata, indexer)
+				}
+			}
 			if job.MetricsClosing == config.AfterJobPause {
 				executedJobs[len(executedJobs)-1].End = time.Now().UTC()
 			}
@@ -193,6 +378,9 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 					log.Error(err.Error())
 					innerRC = rcMeasurement
 				}
+				if globalConfig.OutputFormat == config.OutputFormatCSV {
+					measurementQuantiles = append(measurementQuantiles, measurementsInstance.GetQuantiles()...)
+				}
 				if job.MetricsClosing == config.AfterMeasurements {
 					executedJobs[len(executedJobs)-1].End = time.Now().UTC()
 				}
@@ -206,15 +394,15 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 				measurementsInstance = nil
 			}
 			watcherStopErrs := watcherManager.StopAll()
-			slices.Concat(errs, watcherStopErrs)
+			errs = slices.Concat(errs, watcherStopErrs)
+			jobCp.complete()
 		}
 		if globalConfig.WaitWhenFinished {
 			runWaitList(globalWaitMap, executorMap)
 		}
 		// We initialize garbage collection as soon as the benchmark finishes
 		if globalConfig.GC {
-			//nolint:govet
-			gcCtx, _ = context.WithTimeout(context.Background(), globalConfig.GCTimeout)
+			gcCtx, cancelGC = context.WithTimeout(context.Background(), globalConfig.GCTimeout)
 			for _, job := range jobList {
 				gcWg.Add(1)
 				go garbageCollectJob(gcCtx, job, fmt.Sprintf("kube-burner-job=%s", job.Name), &gcWg)
@@ -236,6 +424,11 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 		}
 		// Make sure that measurements have indexed their stuff before we index metrics
 		msWg.Wait()
+		if globalConfig.OutputFormat == config.OutputFormatCSV {
+			if err := writeCSVSummary(uuid, measurementQuantiles); err != nil {
+				log.Errorf("Error writing CSV summary: %v", err)
+			}
+		}
 		for _, job := range executedJobs {
 			// Declare slice on each iteration
 			var jobAlerts []error
@@ -250,25 +443,44 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 			if len(jobAlerts) > 0 {
 				executionErrors = utilerrors.NewAggregate(jobAlerts).Error()
 			}
-			returnMap[job.JobConfig.Name] = returnPair{innerRC: innerRC, executionErrors: executionErrors}
+			rp := returnPair{innerRC: innerRC, executionErrors: executionErrors}
+			if stats, ok := jobStatsMap[job.JobConfig.Name]; ok {
+				rp.retriedCreations = stats.retriedCreations.Load()
+				rp.failedCreations = stats.failedCreations.Load()
+				rp.patchLatency = stats.patchLatencySummary()
+				rp.interArrival = stats.interArrivalSummary()
+				rp.notReadyObjects = stats.notReadyObjects
+				rp.waitSucceeded = stats.waitSucceededObjects.Load()
+				rp.waitTimedOut = stats.waitTimedOutObjects.Load()
+				rp.applyConflicts = stats.applyConflicts.Load()
+				rp.templateMix = stats.templateMix
+				rp.pausedTime = stats.pausedTime
+				rp.skippedObjects = stats.skippedObjects
+				rp.apiRequests = stats.apiRequests
+				if job.JobConfig.RepeatUntil.Enabled() {
+					rp.repetitions = stats.repetitions
+				}
+			}
+			returnMap[job.JobConfig.Name] = rp
 		}
 		indexMetrics(uuid, executedJobs, returnMap, metricsScraper, configSpec, true, "", false)
 		log.Infof("Finished execution with UUID: %s", uuid)
 		res <- innerRC
 	}()
-	select {
-	case rc = <-res:
-	// When benchmark times out
-	case <-time.After(configSpec.GlobalConfig.Timeout):
-		err := fmt.Errorf("%v timeout reached", configSpec.GlobalConfig.Timeout)
+	// abortRun handles the benchmark interruption, the summaries of the executed jobs are indexed as failed
+	abortRun := func(err error, abortRC int) {
 		log.Error(err.Error())
-		executedJobs[len(executedJobs)-1].End = time.Now().UTC()
+		// The run can be aborted before the first job starts, i.e. while pre-loading images
+		var finishedJobs int
+		if len(executedJobs) > 0 {
+			executedJobs[len(executedJobs)-1].End = time.Now().UTC()
+			finishedJobs = len(executedJobs) - 1
+		}
 		errs = append(errs, err)
-		rc = rcTimeout
+		rc = abortRC
 		if globalConfig.GC {
 			gcCtx, cancelGC = context.WithTimeout(context.Background(), globalConfig.GCTimeout)
-			defer cancelGC()
-			for _, job := range jobList[:len(executedJobs)-1] {
+			for _, job := range jobList[:finishedJobs] {
 				gcWg.Add(1)
 				go garbageCollectJob(gcCtx, job, fmt.Sprintf("kube-burner-job=%s", job.Name), &gcWg)
 			}
@@ -276,6 +488,34 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 		}
 		indexMetrics(uuid, executedJobs, returnMap, metricsScraper, configSpec, false, utilerrors.NewAggregate(errs).Error(), true)
 	}
+	select {
+	case rc = <-res:
+		// All the jobs finished, so there's nothing left to resume
+		if cp != nil {
+			stopCheckpoint()
+			cp.remove()
+		}
+	// When benchmark times out
+	case <-time.After(configSpec.GlobalConfig.Timeout):
+		abortRun(fmt.Errorf("%v timeout reached", configSpec.GlobalConfig.Timeout), rcTimeout)
+	// When an alert fires during the benchmark
+	case firingAlert := <-abortCh:
+		abortRun(fmt.Errorf("benchmark aborted by %s", firingAlert), rcAlertAbort)
+	// When pre-loading images fails or it's interrupted
+	case err := <-preLoadErrCh:
+		abortRC := 1
+		if errors.Is(err, errPreLoadInterrupted) {
+			abortRC = rcInterrupted
+		}
+		abortRun(err, abortRC)
+	case sig := <-interruptCh:
+		signal.Stop(interruptCh)
+		cancel()
+		// The pre-load namespace is cleaned up before going on
+		preLoadWg.Wait()
+		abortRun(fmt.Errorf("benchmark interrupted by %v", sig), rcInterrupted)
+	}
+	stopAlerts()
 	if globalConfig.GC {
 		// When GC is enabled and GCMetrics is disabled, we assume previous GC operation ran in background, so we have to ensure there's no garbage left
 		// Also wait if timeout GC was started, regardless of GCMetrics setting
@@ -288,20 +528,35 @@ func Run(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider,
 			errs = append(errs, fmt.Errorf("garbage collection timeout reached"))
 			rc = rcTimeout
 		}
+		cancelGC()
 	}
 	return rc, utilerrors.NewAggregate(errs)
 }
 
 // If requests, preload the images used in the test into the node
-func handlePreloadImages(executorList []Executor, kubeClientProvider *config.KubeClientProvider) {
+func handlePreloadImages(ctx context.Context, executorList []Executor, kubeClientProvider *config.KubeClientProvider, uuid string, metricsScraper metrics.Scraper) error {
+	var preLoadSummaries []PreLoadSummary
 	clientSet, _ := kubeClientProvider.DefaultClientSet()
 	for _, executor := range executorList {
 		if executor.PreLoadImages && executor.JobType == config.CreationJob {
-			if err := preLoadImages(executor, clientSet); err != nil {
-				log.Fatal(err.Error())
+			summary, err := preLoadImages(ctx, executor, clientSet)
+			if err != nil {
+				return err
+			}
+			if summary != nil && !executor.SkipIndexing {
+				summary.UUID = uuid
+				summary.Metadata = metricsScraper.SummaryMetadata
+				preLoadSummaries = append(preLoadSummaries, *summary)
 			}
 		}
 	}
+	if len(preLoadSummaries) == 0 {
+		return nil
+	}
+	for _, indexer := range metricsScraper.IndexerList {
+		IndexPreLoadSummary(preLoadSummaries, indexer)
+	}
+	return nil
 }
 
 // indexMetrics indexes metrics for the executed jobs
@@ -309,23 +564,58 @@ func indexMetrics(uuid string, executedJobs []prometheus.Job, returnMap map[stri
 	var jobSummaries []JobSummary
 	for _, job := range executedJobs {
 		if !job.JobConfig.SkipIndexing {
+			var retriedCreations, failedCreations int64
+			var patchLatency, interArrival *mmetrics.LatencyQuantiles
+			var notReadyObjects, templateMix map[string]int
+			var repetitions int
+			var waitSucceeded, waitTimedOut, applyConflicts int64
+			var pausedTime time.Duration
+			var skippedObjects []string
+			var apiRequests []config.APIRequestCount
 			if value, exists := returnMap[job.JobConfig.Name]; exists && !isTimeout {
 				innerRC = value.innerRC == 0
 				executionErrors = value.executionErrors
+				retriedCreations = value.retriedCreations
+				failedCreations = value.failedCreations
+				patchLatency = value.patchLatency
+				interArrival = value.interArrival
+				notReadyObjects = value.notReadyObjects
+				repetitions = value.repetitions
+				waitSucceeded = value.waitSucceeded
+				waitTimedOut = value.waitTimedOut
+				applyConflicts = value.applyConflicts
+				templateMix = value.templateMix
+				pausedTime = value.pausedTime
+				skippedObjects = value.skippedObjects
+				apiRequests = value.apiRequests
 			}
 			jobSummaries = append(jobSummaries, JobSummary{
-				UUID:                uuid,
-				Timestamp:           job.Start,
-				EndTimestamp:        job.End,
-				ElapsedTime:         job.End.Sub(job.Start).Round(time.Second).Seconds(),
-				ChurnStartTimestamp: job.ChurnStart,
-				ChurnEndTimestamp:   job.ChurnEnd,
-				JobConfig:           job.JobConfig,
-				Metadata:            metricsScraper.SummaryMetadata,
-				Passed:              innerRC,
-				ExecutionErrors:     executionErrors,
-				Version:             fmt.Sprintf("%v@%v", version.Version, version.GitCommit),
-				MetricName:          jobSummaryMetric,
+				UUID:                 uuid,
+				Timestamp:            job.Start,
+				EndTimestamp:         job.End,
+				ElapsedTime:          (job.End.Sub(job.Start) - pausedTime).Round(time.Second).Seconds(),
+				ChurnStartTimestamp:  job.ChurnStart,
+				ChurnEndTimestamp:    job.ChurnEnd,
+				JobConfig:            job.JobConfig,
+				Metadata:             metricsScraper.SummaryMetadata,
+				Passed:               innerRC,
+				ExecutionErrors:      executionErrors,
+				RetriedCreations:     retriedCreations,
+				FailedCreations:      failedCreations,
+				PatchLatency:         patchLatency,
+				CreationInterArrival: interArrival,
+				NotReadyObjects:      notReadyObjects,
+				Repetitions:          repetitions,
+				WaitSucceededObjects: waitSucceeded,
+				WaitTimedOutObjects:  waitTimedOut,
+				ApplyConflicts:       applyConflicts,
+				TemplateMix:          templateMix,
+				PausedTime:           pausedTime.Round(time.Second).Seconds(),
+				SkippedObjects:       skippedObjects,
+				APIRequests:          apiRequests,
+				TotalAPIRequests:     totalAPIRequests(apiRequests),
+				Version:              fmt.Sprintf("%v@%v", version.Version, version.GitCommit),
+				MetricName:           jobSummaryMetric,
 			})
 		}
 	}
@@ -335,9 +625,11 @@ func indexMetrics(uuid string, executedJobs []prometheus.Job, returnMap map[stri
 	for _, prometheusClient := range metricsScraper.PrometheusClients {
 		prometheusClient.ScrapeJobsMetrics(executedJobs...)
 	}
-	for _, indexer := range configSpec.MetricsEndpoints {
-		if indexer.Type == indexers.LocalIndexer && indexer.CreateTarball {
-			metrics.CreateTarball(indexer.IndexerConfig)
+	for _, metricsEndpoint := range configSpec.MetricsEndpoints {
+		for _, indexer := range append([]config.IndexerConfig{metricsEndpoint.IndexerConfig}, metricsEndpoint.Indexers...) {
+			if indexer.Type == indexers.LocalIndexer && indexer.CreateTarball {
+				metrics.CreateTarball(indexer.IndexerConfig)
+			}
 		}
 	}
 }
@@ -365,9 +657,36 @@ func verifyQPSBurst(job *config.Job) {
 	}
 }
 
+func verifyQPSRamp(job *config.Job) {
+	if job.QPSRamp.EndQPS <= 0 {
+		return
+	}
+	if job.QPSRamp.StartQPS <= 0 || job.QPSRamp.Step <= 0 || job.QPSRamp.Interval <= 0 {
+		log.Fatalf("Job %s: qpsRamp requires startQPS, step and interval greater than 0", job.Name)
+	}
+	if job.QPSRamp.StartQPS > job.QPSRamp.EndQPS {
+		log.Fatalf("Job %s: qpsRamp startQPS must be lower than endQPS", job.Name)
+	}
+	// The client QPS must allow the maximum rate defined by the ramp
+	job.QPS = job.QPSRamp.EndQPS
+}
+
+func verifyRetryBackoff(job *config.Job) {
+	if job.RetryBackoff <= 0 {
+		log.Warnf("Invalid retryBackoff (%v); using default: %v", job.RetryBackoff, time.Second)
+		job.RetryBackoff = time.Second
+	}
+	if job.RetryBackoffFactor <= 1 {
+		log.Warnf("Invalid retryBackoffFactor (%v); using default: %v", job.RetryBackoffFactor, 3)
+		job.RetryBackoffFactor = 3
+	}
+}
+
 func verifyJobDefaults(job *config.Job, defaultTimeout time.Duration) {
 	verifyJobTimeout(job, defaultTimeout)
+	verifyQPSRamp(job)
 	verifyQPSBurst(job)
+	verifyRetryBackoff(job)
 }
 
 // newExecutorList Returns a list of executors
@@ -410,12 +729,20 @@ func garbageCollectJob(ctx context.Context, jobExecutor Executor, labelSelector
 	if err != nil {
 		log.Error(err.Error())
 	}
+	// The existing namespace may hold objects of other runs, only the ones of this run are deleted
+	existingSelector := fmt.Sprintf("%s,kube-burner-uuid=%s", labelSelector, jobExecutor.uuid)
 	for _, obj := range jobExecutor.objects {
 		jobExecutor.limiter.Wait(ctx)
 		if !obj.namespaced {
 			CleanupNonNamespacedResourcesUsingGVR(ctx, jobExecutor, obj, labelSelector)
 		} else if obj.namespace != "" { // When the object has a fixed namespace not generated by kube-burner
 			CleanupNamespaceResourcesUsingGVR(ctx, jobExecutor, obj, obj.namespace, labelSelector)
+		} else if jobExecutor.NamespacedBy == config.NamespacedByExisting {
+			CleanupNamespaceResourcesUsingGVR(ctx, jobExecutor, obj, jobExecutor.Namespace, existingSelector)
 		}
 	}
+	// The existing namespace isn't deleted, so we wait for its objects to be gone instead
+	if jobExecutor.NamespacedBy == config.NamespacedByExisting {
+		waitForDeleteNamespacedResources(ctx, jobExecutor, jobExecutor.Namespace, jobExecutor.objects, existingSelector)
+	}
 }
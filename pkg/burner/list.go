@@ -0,0 +1,144 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	mmetrics "github.com/kube-burner/kube-burner/pkg/measurements/metrics"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// listSample holds the result of a paginated LIST call
+type listSample struct {
+	Timestamp       time.Time `json:"timestamp"`
+	Kind            string    `json:"kind"`
+	LabelSelector   string    `json:"labelSelector,omitempty"`
+	PageSize        int64     `json:"pageSize"`
+	Iteration       int       `json:"iteration"`
+	Pages           int       `json:"pages"`
+	Objects         int       `json:"objects"`
+	TimeToFirstPage int64     `json:"timeToFirstPage"`
+	TimeToComplete  int64     `json:"timeToComplete"`
+	Error           string    `json:"error,omitempty"`
+}
+
+func (ex *Executor) setupListJob(mapper meta.RESTMapper) {
+	log.Debugf("Preparing list job: %s", ex.Name)
+	ex.ExecutionMode = config.ExecutionModeSequential
+	for _, o := range ex.Objects {
+		log.Debugf("Job %s: %s %s with selector %s and page size %d", ex.Name, ex.JobType, o.Kind, labels.Set(o.LabelSelector), o.PageSize)
+		ex.objects = append(ex.objects, newObject(o, mapper, APIVersionV1, ex.embedCfg))
+	}
+	log.Infof("Job %s: %d iterations", ex.Name, ex.JobIterations)
+}
+
+// runList issues the LIST calls of every object, replicas configures the number of concurrent calls per object and iteration
+func (ex *Executor) runList(ctx context.Context) {
+	for i := range ex.JobIterations {
+		for _, obj := range ex.objects {
+			if ctx.Err() != nil {
+				return
+			}
+			var wg sync.WaitGroup
+			for range max(obj.Replicas, 1) {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					ex.stats.addListSample(ex.listObjects(ctx, obj, i))
+				}()
+			}
+			wg.Wait()
+			if ex.ObjectDelay > 0 {
+				log.Infof("Sleeping between objects for %v", ex.ObjectDelay)
+				time.Sleep(ex.ObjectDelay)
+			}
+		}
+		if ex.JobIterationDelay > 0 {
+			log.Infof("Sleeping between job iterations for %v", ex.JobIterationDelay)
+			time.Sleep(ex.JobIterationDelay)
+		}
+		if i%10 == 0 && i > 0 {
+			log.Infof("%v/%v iterations completed", i, ex.JobIterations)
+		}
+	}
+}
+
+// listObjects lists all the objects matching the object's label selector, following the continue tokens until the last page
+func (ex *Executor) listObjects(ctx context.Context, obj *object, iteration int) listSample {
+	sample := listSample{
+		Timestamp:     time.Now().UTC(),
+		Kind:          obj.Kind,
+		LabelSelector: labels.Set(obj.LabelSelector).String(),
+		PageSize:      obj.PageSize,
+		Iteration:     iteration,
+	}
+	listOptions := metav1.ListOptions{
+		LabelSelector: sample.LabelSelector,
+		Limit:         obj.PageSize,
+	}
+	start := time.Now()
+	for {
+		ex.limiter.Wait(ctx)
+		itemList, err := ex.dynamicClient.Resource(obj.gvr).List(ctx, listOptions)
+		if err != nil {
+			log.Errorf("Error listing %s with selector %s: %v", obj.gvr.Resource, sample.LabelSelector, err)
+			sample.Error = err.Error()
+			break
+		}
+		if sample.Pages == 0 {
+			sample.TimeToFirstPage = time.Since(start).Milliseconds()
+		}
+		sample.Pages++
+		sample.Objects += len(itemList.Items)
+		listOptions.Continue = itemList.GetContinue()
+		if listOptions.Continue == "" {
+			break
+		}
+	}
+	sample.TimeToComplete = time.Since(start).Milliseconds()
+	log.Debugf("Listed %d %s in %d pages: %dms", sample.Objects, obj.gvr.Resource, sample.Pages, sample.TimeToComplete)
+	return sample
+}
+
+func (s *jobStats) addListSample(sample listSample) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listSamples = append(s.listSamples, sample)
+}
+
+// listLatencySummary returns the quantiles of the time to first page and time to complete of the successful LIST calls
+func (s *jobStats) listLatencySummary() []mmetrics.LatencyQuantiles {
+	var timeToFirstPage, timeToComplete []float64
+	for _, sample := range s.listSamples {
+		if sample.Error == "" {
+			timeToFirstPage = append(timeToFirstPage, float64(sample.TimeToFirstPage))
+			timeToComplete = append(timeToComplete, float64(sample.TimeToComplete))
+		}
+	}
+	if len(timeToComplete) == 0 {
+		return nil
+	}
+	return []mmetrics.LatencyQuantiles{
+		mmetrics.NewLatencySummary(timeToFirstPage, "TimeToFirstPage"),
+		mmetrics.NewLatencySummary(timeToComplete, "TimeToComplete"),
+	}
+}
@@ -16,31 +16,72 @@ package burner
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"maps"
 
 	"github.com/cloud-bulldozer/go-commons/v2/indexers"
 	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/metrics"
 	log "github.com/sirupsen/logrus"
 )
 
 type JobSummary struct {
-	Timestamp           time.Time      `json:"timestamp"`
-	EndTimestamp        time.Time      `json:"endTimestamp"`
-	ChurnStartTimestamp *time.Time     `json:"churnStartTimestamp,omitempty"`
-	ChurnEndTimestamp   *time.Time     `json:"churnEndTimestamp,omitempty"`
-	ElapsedTime         float64        `json:"elapsedTime"`
-	UUID                string         `json:"uuid"`
-	MetricName          string         `json:"metricName"`
-	JobConfig           config.Job     `json:"jobConfig"`
-	Version             string         `json:"version,omitempty"`
-	Passed              bool           `json:"passed"`
-	ExecutionErrors     string         `json:"executionErrors,omitempty"`
-	Metadata            map[string]any `json:"-"`
+	Timestamp            time.Time                 `json:"timestamp"`
+	EndTimestamp         time.Time                 `json:"endTimestamp"`
+	ChurnStartTimestamp  *time.Time                `json:"churnStartTimestamp,omitempty"`
+	ChurnEndTimestamp    *time.Time                `json:"churnEndTimestamp,omitempty"`
+	ElapsedTime          float64                   `json:"elapsedTime"`
+	UUID                 string                    `json:"uuid"`
+	MetricName           string                    `json:"metricName"`
+	JobConfig            config.Job                `json:"jobConfig"`
+	Version              string                    `json:"version,omitempty"`
+	Passed               bool                      `json:"passed"`
+	ExecutionErrors      string                    `json:"executionErrors,omitempty"`
+	RetriedCreations     int64                     `json:"retriedCreations,omitempty"`
+	FailedCreations      int64                     `json:"failedCreations,omitempty"`
+	PatchLatency         *metrics.LatencyQuantiles `json:"patchLatency,omitempty"`
+	CreationInterArrival *metrics.LatencyQuantiles `json:"creationInterArrival,omitempty"`
+	NotReadyObjects      map[string]int            `json:"notReadyObjects,omitempty"`
+	Repetitions          int                       `json:"repetitions,omitempty"`
+	WaitSucceededObjects int64                     `json:"waitSucceededObjects,omitempty"`
+	WaitTimedOutObjects  int64                     `json:"waitTimedOutObjects,omitempty"`
+	ApplyConflicts       int64                     `json:"applyConflicts,omitempty"`
+	TemplateMix          map[string]int            `json:"templateMix,omitempty"`
+	PausedTime           float64                   `json:"pausedTime,omitempty"`
+	SkippedObjects       []string                  `json:"skippedObjects,omitempty"`
+	APIRequests          []config.APIRequestCount  `json:"apiRequests,omitempty"`
+	TotalAPIRequests     int64                     `json:"totalAPIRequests,omitempty"`
+	Metadata             map[string]any            `json:"-"`
 }
 
-const jobSummaryMetric = "jobSummary"
+// PreLoadSummary describes the duration of the preload stage of a job
+type PreLoadSummary struct {
+	Timestamp    time.Time      `json:"timestamp"`
+	EndTimestamp time.Time      `json:"endTimestamp"`
+	ElapsedTime  float64        `json:"elapsedTime"`
+	UUID         string         `json:"uuid"`
+	MetricName   string         `json:"metricName"`
+	JobName      string         `json:"jobName"`
+	Images       int            `json:"images"`
+	Nodes        int32          `json:"nodes"`
+	ReadyNodes   int32          `json:"readyNodes"`
+	Metadata     map[string]any `json:"-"`
+}
+
+const (
+	jobSummaryMetric            = "jobSummary"
+	preLoadDurationMetric       = "preloadDuration"
+	qpsMetric                   = "activeQPS"
+	liveObjectsMetric           = "liveObjects"
+	podFaultRecoveryMetric      = "podFaultRecovery"
+	podFaultQuantilesMetric     = "podFaultRecoveryQuantiles"
+	listLatencyMetric           = "listLatency"
+	listLatencyQuantilesMetric  = "listLatencyQuantiles"
+	scaleLatencyMetric          = "scaleLatency"
+	scaleLatencyQuantilesMetric = "scaleLatencyQuantiles"
+)
 
 // IndexJobSummary indexes jobSummaries Generates and indexes a document with metadata information of the passed job
 func IndexJobSummary(jobSummaries []JobSummary, indexer indexers.Indexer) {
@@ -64,3 +105,205 @@ func IndexJobSummary(jobSummaries []JobSummary, indexer indexers.Indexer) {
 		log.Info(resp)
 	}
 }
+
+// totalAPIRequests returns the number of API requests across all verbs and resources
+func totalAPIRequests(apiRequests []config.APIRequestCount) int64 {
+	var total int64
+	for _, r := range apiRequests {
+		total += r.Count
+	}
+	return total
+}
+
+// logAPIRequests logs the API requests issued during the given job
+func logAPIRequests(jobName string, apiRequests []config.APIRequestCount) {
+	log.Infof("Job %s issued %d API requests", jobName, totalAPIRequests(apiRequests))
+	for _, r := range apiRequests {
+		log.Debugf("%s %s: %d", r.Verb, r.Resource, r.Count)
+	}
+}
+
+// IndexPreLoadSummary indexes the given preload summaries
+func IndexPreLoadSummary(preLoadSummaries []PreLoadSummary, indexer indexers.Indexer) {
+	log.Info("Indexing pre-load summaries")
+	var preLoadSummariesInt []any
+	for _, summary := range preLoadSummaries {
+		summaryMap := make(map[string]any)
+		j, _ := json.Marshal(summary)
+		json.Unmarshal(j, &summaryMap)
+		maps.Copy(summaryMap, summary.Metadata)
+		preLoadSummariesInt = append(preLoadSummariesInt, summaryMap)
+	}
+	indexingOpts := indexers.IndexingOpts{
+		MetricName: preLoadDurationMetric,
+	}
+	resp, err := indexer.Index(preLoadSummariesInt, indexingOpts)
+	if err != nil {
+		log.Error(err)
+	} else {
+		log.Info(resp)
+	}
+}
+
+// IndexQPSSamples indexes the QPS values set by the QPS ramp-up schedule of the given job
+func IndexQPSSamples(uuid, jobName string, samples []qpsSample, metadata map[string]any, indexer indexers.Indexer) {
+	log.Infof("Indexing QPS samples from job %s", jobName)
+	var qpsSamplesInt []any
+	for _, sample := range samples {
+		sampleMap := map[string]any{
+			"timestamp":  sample.timestamp,
+			"value":      sample.qps,
+			"uuid":       uuid,
+			"jobName":    jobName,
+			"metricName": qpsMetric,
+		}
+		maps.Copy(sampleMap, metadata)
+		qpsSamplesInt = append(qpsSamplesInt, sampleMap)
+	}
+	indexingOpts := indexers.IndexingOpts{
+		MetricName: fmt.Sprintf("%s-%s", qpsMetric, jobName),
+	}
+	resp, err := indexer.Index(qpsSamplesInt, indexingOpts)
+	if err != nil {
+		log.Error(err)
+	} else {
+		log.Info(resp)
+	}
+}
+
+// IndexLiveObjectsSamples indexes the live object count sampled during the steady-state churn of the given job
+func IndexLiveObjectsSamples(uuid, jobName string, samples []liveObjectsSample, metadata map[string]any, indexer indexers.Indexer) {
+	log.Infof("Indexing live object samples from job %s", jobName)
+	var liveObjectsSamplesInt []any
+	for _, sample := range samples {
+		sampleMap := map[string]any{
+			"timestamp":  sample.timestamp,
+			"value":      sample.objects,
+			"uuid":       uuid,
+			"jobName":    jobName,
+			"metricName": liveObjectsMetric,
+		}
+		maps.Copy(sampleMap, metadata)
+		liveObjectsSamplesInt = append(liveObjectsSamplesInt, sampleMap)
+	}
+	indexingOpts := indexers.IndexingOpts{
+		MetricName: fmt.Sprintf("%s-%s", liveObjectsMetric, jobName),
+	}
+	resp, err := indexer.Index(liveObjectsSamplesInt, indexingOpts)
+	if err != nil {
+		log.Error(err)
+	} else {
+		log.Info(resp)
+	}
+}
+
+// IndexPodFaultRecoveries indexes the recoveries of the pods deleted by the fault injection of the given job along with their latency quantiles
+func IndexPodFaultRecoveries(uuid, jobName string, stats *jobStats, metadata map[string]any, indexer indexers.Indexer) {
+	log.Infof("Indexing pod fault recoveries from job %s", jobName)
+	var recoveriesInt, quantilesInt []any
+	for _, recovery := range stats.podFaultRecoveries {
+		recoveryMap := make(map[string]any)
+		j, _ := json.Marshal(recovery)
+		json.Unmarshal(j, &recoveryMap)
+		recoveryMap["uuid"] = uuid
+		recoveryMap["jobName"] = jobName
+		recoveryMap["metricName"] = podFaultRecoveryMetric
+		maps.Copy(recoveryMap, metadata)
+		recoveriesInt = append(recoveriesInt, recoveryMap)
+	}
+	if quantiles := stats.podFaultRecoverySummary(); quantiles != nil {
+		quantiles.UUID = uuid
+		quantiles.JobName = jobName
+		quantiles.MetricName = podFaultQuantilesMetric
+		quantiles.Metadata = metadata
+		quantilesInt = append(quantilesInt, *quantiles)
+	}
+	for metricName, documents := range map[string][]any{podFaultRecoveryMetric: recoveriesInt, podFaultQuantilesMetric: quantilesInt} {
+		if len(documents) == 0 {
+			continue
+		}
+		indexingOpts := indexers.IndexingOpts{
+			MetricName: fmt.Sprintf("%s-%s", metricName, jobName),
+		}
+		resp, err := indexer.Index(documents, indexingOpts)
+		if err != nil {
+			log.Error(err)
+		} else {
+			log.Info(resp)
+		}
+	}
+}
+
+// IndexListSamples indexes the LIST calls issued by the given list job along with their latency quantiles
+func IndexListSamples(uuid, jobName string, stats *jobStats, metadata map[string]any, indexer indexers.Indexer) {
+	log.Infof("Indexing LIST latencies from job %s", jobName)
+	var listSamplesInt, quantilesInt []any
+	for _, sample := range stats.listSamples {
+		sampleMap := make(map[string]any)
+		j, _ := json.Marshal(sample)
+		json.Unmarshal(j, &sampleMap)
+		sampleMap["uuid"] = uuid
+		sampleMap["jobName"] = jobName
+		sampleMap["metricName"] = listLatencyMetric
+		maps.Copy(sampleMap, metadata)
+		listSamplesInt = append(listSamplesInt, sampleMap)
+	}
+	for _, quantiles := range stats.listLatencySummary() {
+		quantiles.UUID = uuid
+		quantiles.JobName = jobName
+		quantiles.MetricName = listLatencyQuantilesMetric
+		quantiles.Metadata = metadata
+		quantilesInt = append(quantilesInt, quantiles)
+	}
+	for metricName, documents := range map[string][]any{listLatencyMetric: listSamplesInt, listLatencyQuantilesMetric: quantilesInt} {
+		if len(documents) == 0 {
+			continue
+		}
+		indexingOpts := indexers.IndexingOpts{
+			MetricName: fmt.Sprintf("%s-%s", metricName, jobName),
+		}
+		resp, err := indexer.Index(documents, indexingOpts)
+		if err != nil {
+			log.Error(err)
+		} else {
+			log.Info(resp)
+		}
+	}
+}
+
+// IndexScaleSamples indexes the scale operations of the given scale job along with their scale-up and scale-down latency quantiles
+func IndexScaleSamples(uuid, jobName string, stats *jobStats, metadata map[string]any, indexer indexers.Indexer) {
+	log.Infof("Indexing scale latencies from job %s", jobName)
+	var scaleSamplesInt, quantilesInt []any
+	for _, sample := range stats.scaleSamples {
+		sampleMap := make(map[string]any)
+		j, _ := json.Marshal(sample)
+		json.Unmarshal(j, &sampleMap)
+		sampleMap["uuid"] = uuid
+		sampleMap["jobName"] = jobName
+		sampleMap["metricName"] = scaleLatencyMetric
+		maps.Copy(sampleMap, metadata)
+		scaleSamplesInt = append(scaleSamplesInt, sampleMap)
+	}
+	for _, quantiles := range stats.scaleLatencySummary() {
+		quantiles.UUID = uuid
+		quantiles.JobName = jobName
+		quantiles.MetricName = scaleLatencyQuantilesMetric
+		quantiles.Metadata = metadata
+		quantilesInt = append(quantilesInt, quantiles)
+	}
+	for metricName, documents := range map[string][]any{scaleLatencyMetric: scaleSamplesInt, scaleLatencyQuantilesMetric: quantilesInt} {
+		if len(documents) == 0 {
+			continue
+		}
+		indexingOpts := indexers.IndexingOpts{
+			MetricName: fmt.Sprintf("%s-%s", metricName, jobName),
+		}
+		resp, err := indexer.Index(documents, indexingOpts)
+		if err != nil {
+			log.Error(err)
+		} else {
+			log.Info(resp)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// nodePoolAffinityWeight weight of the preferred node affinity term of the pool selected for a pod,
+// the highest one so it outweighs the scheduler spreading scores
+const nodePoolAffinityWeight = 100
+
+// kindToPodSpecPath path of the pod spec of the pod-bearing kinds, whose pods are spread across the node pools of nodeWeights
+// and get the affinity, the priorityClassName and the DNS probe of the job
+var kindToPodSpecPath = map[string][]string{
+	Pod:                   {"spec"},
+	Deployment:            {"spec", "template", "spec"},
+	ReplicaSet:            {"spec", "template", "spec"},
+	ReplicationController: {"spec", "template", "spec"},
+	StatefulSet:           {"spec", "template", "spec"},
+	DaemonSet:             {"spec", "template", "spec"},
+	Job:                   {"spec", "template", "spec"},
+	CronJob:               {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// weightedNodePool returns the node pool the given replica is placed on. Like weighted objects, the pool is sampled
+// from a hash of the run UUID, the object, the iteration and the replica, so re-created replicas land on the same pool
+func (ex *Executor) weightedNodePool(obj *object, iteration, replica int) config.NodeWeight {
+	var totalWeight int
+	for _, nodeWeight := range ex.NodeWeights {
+		totalWeight += nodeWeight.Weight
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s-%s-%d-%d", ex.uuid, obj.ObjectTemplate, iteration, replica)
+	pick := int(h.Sum64() % uint64(totalWeight))
+	for _, nodeWeight := range ex.NodeWeights {
+		if pick < nodeWeight.Weight {
+			return nodeWeight
+		}
+		pick -= nodeWeight.Weight
+	}
+	return ex.NodeWeights[len(ex.NodeWeights)-1]
+}
+
+// setNodePoolAffinity appends a preferred node affinity term matching the labels of the node pool to the pod spec of the object,
+// keeping the affinity rules defined by the template. Objects without pod spec are left untouched
+func setNodePoolAffinity(obj *unstructured.Unstructured, nodeWeight config.NodeWeight) {
+	podSpecPath, ok := kindToPodSpecPath[obj.GetKind()]
+	if !ok {
+		return
+	}
+	var matchExpressions []any
+	for _, key := range slices.Sorted(maps.Keys(nodeWeight.Labels)) {
+		matchExpressions = append(matchExpressions, map[string]any{
+			"key":      key,
+			"operator": string(corev1.NodeSelectorOpIn),
+			"values":   []any{nodeWeight.Labels[key]},
+		})
+	}
+	termsPath := append(slices.Clone(podSpecPath), "affinity", "nodeAffinity", "preferredDuringSchedulingIgnoredDuringExecution")
+	terms, _, _ := unstructured.NestedSlice(obj.Object, termsPath...)
+	terms = append(terms, map[string]any{
+		"weight": int64(nodePoolAffinityWeight),
+		"preference": map[string]any{
+			"matchExpressions": matchExpressions,
+		},
+	})
+	unstructured.SetNestedSlice(obj.Object, terms, termsPath...)
+}
+
+// nodePoolsAffinity returns a node affinity requiring any of the node pools of nodeWeights, nil when not configured
+func nodePoolsAffinity(nodeWeights []config.NodeWeight) *corev1.Affinity {
+	if len(nodeWeights) == 0 {
+		return nil
+	}
+	nodeSelector := &corev1.NodeSelector{}
+	for _, nodeWeight := range nodeWeights {
+		var term corev1.NodeSelectorTerm
+		for _, key := range slices.Sorted(maps.Keys(nodeWeight.Labels)) {
+			term.MatchExpressions = append(term.MatchExpressions, corev1.NodeSelectorRequirement{
+				Key:      key,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{nodeWeight.Labels[key]},
+			})
+		}
+		nodeSelector.NodeSelectorTerms = append(nodeSelector.NodeSelectorTerms, term)
+	}
+	return &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: nodeSelector,
+		},
+	}
+}
@@ -16,13 +16,17 @@ package burner
 
 import (
 	"io"
+	"sync"
+	"text/template"
 
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/util"
 	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	"golang.org/x/time/rate"
 )
 
 type object struct {
@@ -32,6 +36,19 @@ type object struct {
 	namespace  string
 	namespaced bool
 	ready      bool
+	// fileFuncs are the template functions reading files relative to the object template
+	fileFuncs template.FuncMap
+	// limiter creation rate limiter of the object replicas, nil when the object has no QPS
+	limiter *rate.Limiter
+	// affinityOverridden warns once about the job affinity overriding the required node affinity of the template
+	affinityOverridden *sync.Once
+}
+
+// templateFileFuncs returns the file template functions for the given object template
+func templateFileFuncs(objectTemplate string, embedCfg *fileutils.EmbedConfiguration) template.FuncMap {
+	return util.TemplateFileFuncs(objectTemplate, func(location string) (io.Reader, error) {
+		return fileutils.GetWorkloadReader(location, embedCfg)
+	})
 }
 
 func newObject(obj config.Object, mapper meta.RESTMapper, defaultAPIVersion string, embedCfg *fileutils.EmbedConfiguration) *object {
@@ -39,7 +56,7 @@ func newObject(obj config.Object, mapper meta.RESTMapper, defaultAPIVersion stri
 		obj.APIVersion = defaultAPIVersion
 	}
 
-	if len(obj.LabelSelector) == 0 {
+	if len(obj.LabelSelector) == 0 && len(obj.FieldSelector) == 0 {
 		log.Fatalf("Empty labelSelectors not allowed with: %s", obj.Kind)
 	}
 
@@ -66,6 +83,7 @@ func newObject(obj config.Object, mapper meta.RESTMapper, defaultAPIVersion stri
 			log.Fatalf("Error reading template %s: %s", obj.ObjectTemplate, err)
 		}
 		o.objectSpec = t
+		o.fileFuncs = templateFileFuncs(obj.ObjectTemplate, embedCfg)
 	}
 
 	return &o
@@ -18,6 +18,7 @@ import (
 	"context"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/kube-burner/kube-burner/pkg/config"
 	log "github.com/sirupsen/logrus"
@@ -29,6 +30,22 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
+var (
+	// patchTypeAliases short names of the supported patch types
+	patchTypeAliases = map[string]types.PatchType{
+		"json":      types.JSONPatchType,
+		"merge":     types.MergePatchType,
+		"strategic": types.StrategicMergePatchType,
+		"apply":     types.ApplyPatchType,
+	}
+	supportedPatchTypes = map[types.PatchType]struct{}{
+		types.JSONPatchType:           {},
+		types.MergePatchType:          {},
+		types.StrategicMergePatchType: {},
+		types.ApplyPatchType:          {},
+	}
+)
+
 func (ex *Executor) setupPatchJob(mapper meta.RESTMapper) {
 	log.Debugf("Preparing patch job: %s", ex.Name)
 	ex.itemHandler = patchHandler
@@ -43,6 +60,12 @@ func (ex *Executor) setupPatchJob(mapper meta.RESTMapper) {
 		if len(o.PatchType) == 0 {
 			log.Fatalln("Empty Patch Type not allowed")
 		}
+		if patchType, ok := patchTypeAliases[o.PatchType]; ok {
+			o.PatchType = string(patchType)
+		}
+		if _, ok := supportedPatchTypes[types.PatchType(o.PatchType)]; !ok {
+			log.Fatalf("Unsupported patch type: %s", o.PatchType)
+		}
 		log.Infof("Job %s: %s %s with selector %s", ex.Name, ex.JobType, o.Kind, labels.Set(o.LabelSelector))
 		ex.objects = append(ex.objects, newObject(o, mapper, APIVersionV1, ex.embedCfg))
 	}
@@ -78,6 +101,7 @@ func patchHandler(ex *Executor, obj *object, originalItem unstructured.Unstructu
 
 	var uns *unstructured.Unstructured
 	var err error
+	start := time.Now()
 	if obj.namespaced {
 		uns, err = ex.dynamicClient.Resource(obj.gvr).Namespace(ns).
 			Patch(context.TODO(), originalItem.GetName(),
@@ -87,6 +111,7 @@ func patchHandler(ex *Executor, obj *object, originalItem unstructured.Unstructu
 			Patch(context.TODO(), originalItem.GetName(),
 				types.PatchType(obj.PatchType), data, patchOptions)
 	}
+	latency := time.Since(start)
 	if err != nil {
 		if errors.IsForbidden(err) {
 			log.Fatalf("Authorization error patching %s/%s: %s", originalItem.GetKind(), originalItem.GetName(), err)
@@ -95,6 +120,7 @@ func patchHandler(ex *Executor, obj *object, originalItem unstructured.Unstructu
 				originalItem.GetName(), ns, err)
 		}
 	} else {
+		ex.stats.addPatchLatency(latency)
 		log.Debugf("Patched %s/%s in namespace %s", uns.GetKind(), uns.GetName(), ns)
 	}
 }
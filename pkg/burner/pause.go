@@ -0,0 +1,101 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// pauseControl pauses and resumes the object operations of the running jobs, watchers and measurements keep running while paused
+type pauseControl struct {
+	mu       sync.Mutex
+	resumeCh chan struct{}
+	pausedAt time.Time
+	// paused total time paused, excluding the ongoing pause
+	paused time.Duration
+}
+
+// creationPause is shared by all the executors, as signals are delivered to the process
+var creationPause = &pauseControl{}
+
+// pause pauses the object operations until resume is called
+func (pc *pauseControl) pause() {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	if pc.resumeCh != nil {
+		log.Info("Already paused")
+		return
+	}
+	log.Info("⏸️ Pausing object operations, watchers and measurements keep running")
+	pc.resumeCh = make(chan struct{})
+	pc.pausedAt = time.Now()
+}
+
+// resume resumes the paused object operations
+func (pc *pauseControl) resume() {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	if pc.resumeCh == nil {
+		log.Info("Not paused")
+		return
+	}
+	pause := time.Since(pc.pausedAt)
+	pc.paused += pause
+	log.Infof("▶️ Resuming object operations after %v", pause.Round(time.Second))
+	close(pc.resumeCh)
+	pc.resumeCh = nil
+}
+
+// wait blocks while paused, returns true when it was paused
+func (pc *pauseControl) wait(ctx context.Context) bool {
+	pc.mu.Lock()
+	resumeCh := pc.resumeCh
+	pc.mu.Unlock()
+	if resumeCh == nil {
+		return false
+	}
+	select {
+	case <-ctx.Done():
+	case <-resumeCh:
+	}
+	return true
+}
+
+// sleep waits for the given duration and then while paused, returns false when the context is done
+func (pc *pauseControl) sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+	}
+	pc.wait(ctx)
+	return ctx.Err() == nil
+}
+
+// pausedTime returns the total time paused, including the ongoing pause
+func (pc *pauseControl) pausedTime() time.Duration {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	if pc.resumeCh != nil {
+		return pc.paused + time.Since(pc.pausedAt)
+	}
+	return pc.paused
+}
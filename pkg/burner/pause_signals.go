@@ -0,0 +1,48 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package burner
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// watchPauseSignals pauses the object operations on SIGUSR1 and resumes them on SIGUSR2, until the context is cancelled
+func watchPauseSignals(ctx context.Context, pc *pauseControl) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGUSR1, syscall.SIGUSR2)
+	log.Debugf("Send SIGUSR1 to pause the object operations and SIGUSR2 to resume them: kill -USR1 %d", os.Getpid())
+	go func() {
+		defer signal.Stop(sigCh)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case sig := <-sigCh:
+				if sig == syscall.SIGUSR1 {
+					pc.pause()
+				} else {
+					pc.resume()
+				}
+			}
+		}
+	}()
+}
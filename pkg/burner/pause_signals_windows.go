@@ -0,0 +1,26 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// watchPauseSignals is a no-op, SIGUSR1 and SIGUSR2 aren't available in Windows
+func watchPauseSignals(ctx context.Context, pc *pauseControl) {
+	log.Debug("Pausing object operations through signals isn't supported in Windows")
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPauseControlSleep(t *testing.T) {
+	pc := &pauseControl{}
+	if !pc.sleep(context.Background(), time.Millisecond) {
+		t.Fatal("sleep returned false with a live context")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	if pc.sleep(ctx, time.Hour) {
+		t.Fatal("sleep returned true with a canceled context")
+	}
+	if time.Since(start) > time.Second {
+		t.Fatal("sleep didn't return when the context was canceled")
+	}
+	pc.pause()
+	done := make(chan bool)
+	go func() {
+		done <- pc.sleep(context.Background(), time.Millisecond)
+	}()
+	select {
+	case <-done:
+		t.Fatal("sleep returned while paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+	pc.resume()
+	if !<-done {
+		t.Fatal("sleep returned false after resuming")
+	}
+}
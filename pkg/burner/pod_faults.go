@@ -0,0 +1,260 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	mmetrics "github.com/kube-burner/kube-burner/pkg/measurements/metrics"
+	"github.com/kube-burner/kube-burner/pkg/watchers"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const defaultPodFaultsRecoveryTimeout = 5 * time.Minute
+
+// podFaultRecovery recovery of a pod deleted by the fault injection, measured until its replacement pod is ready
+type podFaultRecovery struct {
+	// Timestamp time the pod was deleted
+	Timestamp      time.Time `json:"timestamp"`
+	Namespace      string    `json:"namespace"`
+	DeletedPod     string    `json:"deletedPod"`
+	ReplacementPod string    `json:"replacementPod,omitempty"`
+	OwnerKind      string    `json:"ownerKind"`
+	OwnerName      string    `json:"ownerName"`
+	Recovered      bool      `json:"recovered"`
+	// RecoveryLatency time in milliseconds from the pod deletion until the replacement pod is ready
+	RecoveryLatency int64 `json:"recoveryLatency"`
+	deletedUID      types.UID
+}
+
+// podFaultInjector deletes pods of the job on every interval, and tracks their replacement pods through a pod informer
+type podFaultInjector struct {
+	ex      *Executor
+	watcher *watchers.Watcher
+	mu      sync.Mutex
+	// pending deleted pods whose replacement isn't ready yet, indexed by the UID of their controller
+	pending map[types.UID][]*podFaultRecovery
+	// replacements pods already accounted as the replacement of a deleted pod
+	replacements map[types.UID]bool
+	recoveries   []*podFaultRecovery
+}
+
+// startPodFaults starts deleting a percentage of the running pods of the job on every interval,
+// the returned function stops the deletions, waits for the pending recoveries and must be called once the job finishes
+func (ex *Executor) startPodFaults(ctx context.Context) func() {
+	if !ex.PodFaults.Enabled() {
+		return func() {}
+	}
+	podLabels := map[string]string{
+		"kube-burner-job":  ex.Name,
+		"kube-burner-uuid": ex.uuid,
+	}
+	maps.Copy(podLabels, ex.PodFaults.LabelSelector)
+	pfi := &podFaultInjector{
+		ex:           ex,
+		pending:      make(map[types.UID][]*podFaultRecovery),
+		replacements: make(map[types.UID]bool),
+	}
+	pfi.watcher = watchers.NewWatcher(
+		ex.clientSet.CoreV1().RESTClient().(*rest.RESTClient),
+		"podFaultsWatcher",
+		"pods",
+		corev1.NamespaceAll,
+		func(options *metav1.ListOptions) {
+			options.LabelSelector = labels.SelectorFromSet(podLabels).String()
+		},
+		nil,
+	)
+	pfi.watcher.Informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: pfi.handlePod,
+		UpdateFunc: func(oldObj, newObj any) {
+			pfi.handlePod(newObj)
+		},
+	})
+	if err := pfi.watcher.StartAndCacheSync(); err != nil {
+		log.Errorf("Pod faults: %v", err)
+	}
+	log.Infof("Job %s: deleting %d%% of the pods matching %s every %v", ex.Name, ex.PodFaults.Percent, labels.SelectorFromSet(podLabels), ex.PodFaults.Interval)
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(ex.PodFaults.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				pfi.deletePods(ctx)
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+		pfi.stop()
+	}
+}
+
+// deletePods deletes the given percentage of the running pods owned by a controller, bare pods aren't replaced so they're never deleted
+func (pfi *podFaultInjector) deletePods(ctx context.Context) {
+	var candidates []*corev1.Pod
+	for _, obj := range pfi.watcher.Informer.GetStore().List() {
+		pod := obj.(*corev1.Pod)
+		if pod.Status.Phase == corev1.PodRunning && pod.DeletionTimestamp == nil && metav1.GetControllerOf(pod) != nil {
+			candidates = append(candidates, pod)
+		}
+	}
+	if len(candidates) == 0 {
+		log.Warnf("Pod faults: no running pods to delete")
+		return
+	}
+	count := int(math.Ceil(float64(len(candidates)*pfi.ex.PodFaults.Percent) / 100))
+	log.Infof("Pod faults: deleting %d out of %d running pods", count, len(candidates))
+	for _, i := range rand.Perm(len(candidates))[:count] {
+		pod := candidates[i]
+		owner := metav1.GetControllerOf(pod)
+		recovery := &podFaultRecovery{
+			Timestamp:  time.Now().UTC(),
+			Namespace:  pod.Namespace,
+			DeletedPod: pod.Name,
+			OwnerKind:  owner.Kind,
+			OwnerName:  owner.Name,
+			deletedUID: pod.UID,
+		}
+		// The recovery is tracked before the deletion, as the replacement pod can be observed before the request returns
+		pfi.mu.Lock()
+		pfi.pending[owner.UID] = append(pfi.pending[owner.UID], recovery)
+		pfi.mu.Unlock()
+		err := pfi.ex.clientSet.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+		pfi.mu.Lock()
+		if err != nil {
+			if !kerrors.IsNotFound(err) && ctx.Err() == nil {
+				log.Errorf("Pod faults: error deleting pod %s/%s: %v", pod.Namespace, pod.Name, err)
+			}
+			pending := pfi.pending[owner.UID]
+			pfi.pending[owner.UID] = pending[:len(pending)-1]
+		} else {
+			pfi.recoveries = append(pfi.recoveries, recovery)
+		}
+		pfi.mu.Unlock()
+	}
+}
+
+// handlePod accounts a ready pod as the replacement of the oldest pending deletion of its controller,
+// as long as it was created after the deletion
+func (pfi *podFaultInjector) handlePod(obj any) {
+	pod := obj.(*corev1.Pod)
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || pod.DeletionTimestamp != nil || !podReady(pod) {
+		return
+	}
+	now := time.Now().UTC()
+	pfi.mu.Lock()
+	defer pfi.mu.Unlock()
+	if pfi.replacements[pod.UID] {
+		return
+	}
+	pending := pfi.pending[owner.UID]
+	for i, recovery := range pending {
+		// creationTimestamp has a resolution of seconds
+		if pod.UID == recovery.deletedUID || pod.CreationTimestamp.Before(&metav1.Time{Time: recovery.Timestamp.Truncate(time.Second)}) {
+			continue
+		}
+		recovery.Recovered = true
+		recovery.ReplacementPod = pod.Name
+		recovery.RecoveryLatency = now.Sub(recovery.Timestamp).Milliseconds()
+		log.Debugf("Pod faults: pod %s/%s replaced by %s in %dms", recovery.Namespace, recovery.DeletedPod, pod.Name, recovery.RecoveryLatency)
+		pfi.pending[owner.UID] = append(pending[:i], pending[i+1:]...)
+		pfi.replacements[pod.UID] = true
+		return
+	}
+}
+
+// stop waits for the replacements of the deleted pods to be ready, up to the recovery timeout, and records the recoveries
+func (pfi *podFaultInjector) stop() {
+	recoveryTimeout := cmp.Or(pfi.ex.PodFaults.RecoveryTimeout, defaultPodFaultsRecoveryTimeout)
+	pendingRecoveries := func() int {
+		pfi.mu.Lock()
+		defer pfi.mu.Unlock()
+		var pending int
+		for _, recoveries := range pfi.pending {
+			pending += len(recoveries)
+		}
+		return pending
+	}
+	if pendingRecoveries() > 0 {
+		log.Infof("Pod faults: waiting up to %v for the replacement pods to be ready", recoveryTimeout)
+		wait.PollUntilContextTimeout(context.TODO(), time.Second, recoveryTimeout, true, func(context.Context) (bool, error) {
+			return pendingRecoveries() == 0, nil
+		})
+	}
+	pfi.watcher.StopWatcher()
+	pfi.mu.Lock()
+	defer pfi.mu.Unlock()
+	var notRecovered int
+	for _, recovery := range pfi.recoveries {
+		if !recovery.Recovered {
+			notRecovered++
+		}
+		pfi.ex.stats.podFaultRecoveries = append(pfi.ex.stats.podFaultRecoveries, *recovery)
+	}
+	if notRecovered > 0 {
+		log.Errorf("Pod faults: %d out of %d deleted pods weren't replaced by a ready pod within %v", notRecovered, len(pfi.recoveries), recoveryTimeout)
+	}
+	if summary := pfi.ex.stats.podFaultRecoverySummary(); summary != nil {
+		log.Infof("%s: %s %s", pfi.ex.Name, summary.QuantileName, summary.Summary(1, "ms"))
+	}
+}
+
+// podFaultRecoverySummary returns the recovery latency quantiles of the deleted pods, nil when none of them was recovered
+func (s *jobStats) podFaultRecoverySummary() *mmetrics.LatencyQuantiles {
+	var latencies []float64
+	for _, recovery := range s.podFaultRecoveries {
+		if recovery.Recovered {
+			latencies = append(latencies, float64(recovery.RecoveryLatency))
+		}
+	}
+	if len(latencies) == 0 {
+		return nil
+	}
+	summary := mmetrics.NewLatencySummary(latencies, "PodFaultRecovery")
+	return &summary
+}
+
+// podReady returns whether the pod Ready condition is true
+func podReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -16,24 +16,52 @@ package burner
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	"maps"
 
+	"github.com/kube-burner/kube-burner/pkg/config"
 	"github.com/kube-burner/kube-burner/pkg/util"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/jsonpath"
 	"k8s.io/utils/ptr"
 )
 
-const preLoadNs = "preload-kube-burner"
+const preLoadNsPrefix = "preload"
+
+// errPreLoadInterrupted is returned when kube-burner is interrupted while pre-loading images
+var errPreLoadInterrupted = errors.New("pre-load: interrupted")
+
+// preLoadNamespace returns the pre-load namespace of the given job, unique per job and run. The job name is
+// truncated to keep the namespace name within the 63 characters allowed
+func preLoadNamespace(jobName, uuid string) string {
+	maxJobName := validation.DNS1123LabelMaxLength - len(preLoadNsPrefix) - len(uuid) - 2
+	jobName = strings.ReplaceAll(jobName, ".", "-")
+	if len(jobName) > maxJobName {
+		jobName = strings.TrimRight(jobName[:maxJobName], "-")
+	}
+	return fmt.Sprintf("%s-%s-%s", preLoadNsPrefix, jobName, uuid)
+}
 
 // NestedPod represents a pod nested in a higher level object such as deployment or a daemonset
 type NestedPod struct {
@@ -45,6 +73,20 @@ type NestedPod struct {
 	} `json:"spec"`
 }
 
+// NestedCronJobPod represents a pod nested in a CronJob's job template
+type NestedCronJobPod struct {
+	// Spec represents the object spec
+	Spec struct {
+		JobTemplate struct {
+			Spec struct {
+				Template struct {
+					corev1.PodSpec `json:"spec"`
+				} `json:"template"`
+			} `json:"spec"`
+		} `json:"jobTemplate"`
+	} `json:"spec"`
+}
+
 type VMI struct {
 	Spec struct {
 		Volumes []struct {
@@ -55,8 +97,24 @@ type VMI struct {
 	} `yaml:"spec"`
 }
 
+// DataVolumeSpec represents the spec of a DataVolume or a dataVolumeTemplate
+type DataVolumeSpec struct {
+	Source struct {
+		Registry struct {
+			URL string `yaml:"url"`
+		} `yaml:"registry"`
+	} `yaml:"source"`
+}
+
+type DV struct {
+	Spec DataVolumeSpec `yaml:"spec"`
+}
+
 type NestedVM struct {
 	Spec struct {
+		DataVolumeTemplates []struct {
+			Spec DataVolumeSpec `yaml:"spec"`
+		} `yaml:"dataVolumeTemplates"`
 		Template struct {
 			Spec struct {
 				Volumes []struct {
@@ -69,59 +127,114 @@ type NestedVM struct {
 	} `yaml:"spec"`
 }
 
-func preLoadImages(job Executor, clientSet kubernetes.Interface) error {
+// preLoadResources holds the resources discovered from the job objects that are required by the pre-load stage
+type preLoadResources struct {
+	images []string
+	// imagePullSecrets names of the pull secrets referenced by the workload pods
+	imagePullSecrets []string
+	// secrets pull secrets defined in the job objects, copied into the pre-load namespace
+	secrets []corev1.Secret
+}
+
+// PreLoadDryRun prints the images that would be pre-loaded by each job without creating anything in the cluster
+func PreLoadDryRun(configSpec config.Spec, kubeClientProvider *config.KubeClientProvider, embedCfg *fileutils.EmbedConfiguration) error {
+	for _, job := range newExecutorList(configSpec, kubeClientProvider, embedCfg) {
+		if !job.PreLoadImages || job.JobType != config.CreationJob {
+			continue
+		}
+		resources, err := getJobImages(job)
+		if err != nil {
+			return fmt.Errorf("pre-load: %v", err)
+		}
+		fmt.Printf("%s:\n", job.Name)
+		for _, image := range resources.images {
+			fmt.Printf("  - %s\n", image)
+		}
+	}
+	return nil
+}
+
+func preLoadImages(runCtx context.Context, job Executor, clientSet kubernetes.Interface) (*PreLoadSummary, error) {
 	log.Info("Pre-load: images from job ", job.Name)
-	imageList, err := getJobImages(job)
+	resources, err := getJobImages(job)
 	if err != nil {
-		return fmt.Errorf("pre-load: %v", err)
+		return nil, fmt.Errorf("pre-load: %v", err)
 	}
-	if len(imageList) == 0 {
+	if len(resources.images) == 0 {
 		log.Infof("No images found to pre-load, continuing")
-		return nil
+		return nil, nil
 	}
-	err = createDSs(clientSet, imageList, job.NamespaceLabels, job.NamespaceAnnotations, job.PreLoadNodeLabels)
+	// The namespace is unique per job and run so neither the jobs of a run nor concurrent kube-burner runs interfere with each other
+	preLoadNs := preLoadNamespace(job.Name, job.uuid)
+	// Cleanup the preload namespace even if kube-burner is interrupted, or the run aborted, while pre-loading
+	ctx, stop := signal.NotifyContext(runCtx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	defer func() {
+		// 5 minutes should be more than enough to cleanup this namespace
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+		util.CleanupNamespaces(cleanupCtx, clientSet, fmt.Sprintf("kube-burner-preload=true,kube-burner-preload-job=%s,kube-burner-uuid=%s", job.Name, job.uuid))
+	}()
+	start := time.Now().UTC()
+	err = createDSs(clientSet, job, preLoadNs, resources)
 	if err != nil {
-		return fmt.Errorf("pre-load: %v", err)
-	}
-	log.Infof("Pre-load: Sleeping for %v", job.PreLoadPeriod)
-	time.Sleep(job.PreLoadPeriod)
-	// 5 minutes should be more than enough to cleanup this namespace
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-	defer cancel()
-	util.CleanupNamespaces(ctx, clientSet, "kube-burner-preload=true")
-	return nil
+		return nil, fmt.Errorf("pre-load: %v", err)
+	}
+	log.Infof("Pre-load: Waiting up to %v for DaemonSets to be ready", job.PreLoadPeriod)
+	ready, desired := waitForDSs(ctx, clientSet, preLoadNs, job.PreLoadPeriod)
+	end := time.Now().UTC()
+	if ctx.Err() != nil {
+		return nil, errPreLoadInterrupted
+	}
+	reportPreLoadFailures(clientSet, preLoadNs)
+	return &PreLoadSummary{
+		Timestamp:    start,
+		EndTimestamp: end,
+		ElapsedTime:  end.Sub(start).Round(time.Second).Seconds(),
+		MetricName:   preLoadDurationMetric,
+		JobName:      job.Name,
+		Images:       len(resources.images),
+		Nodes:        desired,
+		ReadyNodes:   ready,
+	}, nil
 }
 
-func getJobImages(job Executor) ([]string, error) {
-	var imageList []string
+func getJobImages(job Executor) (preLoadResources, error) {
+	var resources preLoadResources
+	var secrets []corev1.Secret
 	var unstructuredObject unstructured.Unstructured
 	for _, object := range job.objects {
-		renderedObj, err := util.RenderTemplate(object.objectSpec, object.InputVars, util.MissingKeyZero, job.functionTemplates)
+		var podSpecs []corev1.PodSpec
+		renderedObj, err := util.RenderObjectTemplate(object.objectSpec, object.InputVars, util.MissingKeyZero, job.functionTemplates, job.uuid, object.fileFuncs)
 		if err != nil {
-			return imageList, err
+			return resources, err
 		}
 		yamlToUnstructured(object.ObjectTemplate, renderedObj, &unstructuredObject)
 		switch unstructuredObject.GetKind() {
 		case Deployment, DaemonSet, ReplicaSet, Job, StatefulSet:
 			var pod NestedPod
 			runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObject.UnstructuredContent(), &pod)
-			for _, i := range pod.Spec.Template.Containers {
-				imageList = append(imageList, i.Image)
-			}
+			podSpecs = append(podSpecs, pod.Spec.Template.PodSpec)
+		case CronJob:
+			var pod NestedCronJobPod
+			runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObject.UnstructuredContent(), &pod)
+			podSpecs = append(podSpecs, pod.Spec.JobTemplate.Spec.Template.PodSpec)
 		case Pod:
 			var pod corev1.Pod
 			runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObject.UnstructuredContent(), &pod)
-			for _, i := range pod.Spec.Containers {
-				if i.Image != "" {
-					imageList = append(imageList, i.Image)
-				}
+			podSpecs = append(podSpecs, pod.Spec)
+		case Secret:
+			var secret corev1.Secret
+			runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObject.UnstructuredContent(), &secret)
+			if secret.Type == corev1.SecretTypeDockerConfigJson || secret.Type == corev1.SecretTypeDockercfg {
+				secrets = append(secrets, secret)
 			}
 		case VirtualMachineInstance:
 			var vmi VMI
 			yaml.Unmarshal(renderedObj, &vmi)
 			for _, volume := range vmi.Spec.Volumes {
 				if volume.ContainerDisk.Image != "" {
-					imageList = append(imageList, volume.ContainerDisk.Image)
+					resources.images = append(resources.images, volume.ContainerDisk.Image)
 				}
 			}
 		case VirtualMachine, VirtualMachineInstanceReplicaSet:
@@ -129,73 +242,332 @@ func getJobImages(job Executor) ([]string, error) {
 			yaml.Unmarshal(renderedObj, &nestedVM)
 			for _, volume := range nestedVM.Spec.Template.Spec.Volumes {
 				if volume.ContainerDisk.Image != "" {
-					imageList = append(imageList, volume.ContainerDisk.Image)
+					resources.images = append(resources.images, volume.ContainerDisk.Image)
 				}
 			}
+			for _, dvTemplate := range nestedVM.Spec.DataVolumeTemplates {
+				if image := getRegistryImage(dvTemplate.Spec); image != "" {
+					resources.images = append(resources.images, image)
+				}
+			}
+		case DataVolume:
+			var dv DV
+			yaml.Unmarshal(renderedObj, &dv)
+			if image := getRegistryImage(dv.Spec); image != "" {
+				resources.images = append(resources.images, image)
+			}
+		}
+		resources.images = append(resources.images, getImagesFromPaths(unstructuredObject, object.PreLoadImagePaths)...)
+		for _, podSpec := range podSpecs {
+			resources.images = append(resources.images, getPodSpecImages(podSpec)...)
+			for _, pullSecret := range podSpec.ImagePullSecrets {
+				if pullSecret.Name != "" && !slices.Contains(resources.imagePullSecrets, pullSecret.Name) {
+					resources.imagePullSecrets = append(resources.imagePullSecrets, pullSecret.Name)
+				}
+			}
+		}
+	}
+	resources.images = dedupImages(resources.images)
+	// Only the pull secrets referenced by the workload pods are copied
+	for _, secret := range secrets {
+		if slices.Contains(resources.imagePullSecrets, secret.Name) {
+			resources.secrets = append(resources.secrets, secret)
 		}
 	}
-	return imageList, nil
+	return resources, nil
 }
 
-func createDSs(clientSet kubernetes.Interface, imageList []string, namespaceLabels map[string]string, namespaceAnnotations map[string]string, nodeSelectorLabels map[string]string) error {
+// getRegistryImage returns the image of a DataVolume registry source, only docker:// URLs are supported
+func getRegistryImage(dvSpec DataVolumeSpec) string {
+	if image, found := strings.CutPrefix(dvSpec.Source.Registry.URL, "docker://"); found {
+		return image
+	}
+	return ""
+}
+
+// dedupImages removes duplicated images preserving the first-seen order
+func dedupImages(imageList []string) []string {
+	var dedupedList []string
+	seen := make(map[string]struct{}, len(imageList))
+	for _, image := range imageList {
+		if _, ok := seen[image]; !ok {
+			seen[image] = struct{}{}
+			dedupedList = append(dedupedList, image)
+		}
+	}
+	return dedupedList
+}
+
+// getImagesFromPaths evaluates the given JSONPath expressions against the object and returns the images found
+func getImagesFromPaths(obj unstructured.Unstructured, paths []string) []string {
+	var images []string
+	for _, path := range paths {
+		if !strings.HasPrefix(path, "{") {
+			path = fmt.Sprintf("{%s}", path)
+		}
+		jp := jsonpath.New("preload").AllowMissingKeys(true)
+		if err := jp.Parse(path); err != nil {
+			log.Warnf("Pre-load: invalid image path %s: %v", path, err)
+			continue
+		}
+		results, err := jp.FindResults(obj.UnstructuredContent())
+		if err != nil {
+			log.Warnf("Pre-load: error evaluating image path %s in %s: %v", path, obj.GetKind(), err)
+			continue
+		}
+		for _, result := range results {
+			for _, value := range result {
+				if image, ok := value.Interface().(string); ok && image != "" {
+					images = append(images, image)
+				}
+			}
+		}
+	}
+	return images
+}
+
+// getPodSpecImages returns the images referenced by the init containers, including native sidecars,
+// containers and ephemeral containers of the given pod spec
+func getPodSpecImages(podSpec corev1.PodSpec) []string {
+	var imageList []string
+	for _, c := range slices.Concat(podSpec.InitContainers, podSpec.Containers) {
+		if c.Image != "" {
+			imageList = append(imageList, c.Image)
+		}
+	}
+	for _, c := range podSpec.EphemeralContainers {
+		if c.Image != "" {
+			imageList = append(imageList, c.Image)
+		}
+	}
+	return imageList
+}
+
+func createDSs(clientSet kubernetes.Interface, job Executor, preLoadNs string, resources preLoadResources) error {
+	var imagePullSecrets []corev1.LocalObjectReference
 	nsLabels := map[string]string{
 		"kube-burner-preload": "true",
 	}
 	nsAnnotations := make(map[string]string)
-	maps.Copy(nsLabels, namespaceLabels)
-	maps.Copy(nsAnnotations, namespaceAnnotations)
+	maps.Copy(nsLabels, job.NamespaceLabels)
+	nsLabels["kube-burner-uuid"] = job.uuid
+	// Not labeled with kube-burner-job, so the garbage collection of a job with the same name doesn't delete it
+	nsLabels["kube-burner-preload-job"] = job.Name
+	maps.Copy(nsAnnotations, job.NamespaceAnnotations)
 	if err := util.CreateNamespace(clientSet, preLoadNs, nsLabels, nsAnnotations); err != nil {
-		log.Fatal(err)
+		return err
+	}
+	for _, secret := range resources.secrets {
+		if err := createPreLoadSecret(clientSet, preLoadNs, secret); err != nil {
+			return err
+		}
+	}
+	for _, secretRef := range job.PreLoadImagePullSecrets {
+		secret, err := getPreLoadSecret(clientSet, secretRef)
+		if err != nil {
+			return err
+		}
+		if err := createPreLoadSecret(clientSet, preLoadNs, *secret); err != nil {
+			return err
+		}
+		if !slices.Contains(resources.imagePullSecrets, secret.Name) {
+			resources.imagePullSecrets = append(resources.imagePullSecrets, secret.Name)
+		}
+	}
+	for _, name := range resources.imagePullSecrets {
+		imagePullSecrets = append(imagePullSecrets, corev1.LocalObjectReference{Name: name})
+	}
+	tolerations := job.PreLoadTolerations
+	if job.PreLoadTolerateAll {
+		tolerations = []corev1.Toleration{{Operator: corev1.TolerationOpExists}}
+	}
+	containerResources := corev1.ResourceRequirements{
+		Requests: toResourceList(job.PreLoadResources.Requests),
+		Limits:   toResourceList(job.PreLoadResources.Limits),
+	}
+	chunkSize := len(resources.images)
+	if job.PreLoadImagesPerDaemonSet > 0 {
+		chunkSize = job.PreLoadImagesPerDaemonSet
 	}
 	dsName := "preload"
-	ds := appsv1.DaemonSet{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       string(DaemonSet),
-			APIVersion: "apps/v1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: dsName,
-		},
-		Spec: appsv1.DaemonSetSpec{
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"app": dsName},
+	for dsIndex, images := range slices.Collect(slices.Chunk(resources.images, chunkSize)) {
+		// Every DaemonSet needs its own selector to not compete with others for the same pods
+		podLabels := map[string]string{
+			"app":            dsName,
+			"kube-burner-ds": strconv.Itoa(dsIndex),
+		}
+		ds := appsv1.DaemonSet{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       string(DaemonSet),
+				APIVersion: "apps/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: dsName + "-",
 			},
-			Template: corev1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{"app": dsName},
+			Spec: appsv1.DaemonSetSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: podLabels,
 				},
-				Spec: corev1.PodSpec{
-					TerminationGracePeriodSeconds: ptr.To[int64](0),
-					InitContainers:                []corev1.Container{},
-					// Only Always restart policy is supported
-					Containers: []corev1.Container{
-						{
-							Name:            "sleep",
-							Image:           "registry.k8s.io/pause:3.1",
-							ImagePullPolicy: corev1.PullAlways,
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: podLabels,
+					},
+					Spec: corev1.PodSpec{
+						TerminationGracePeriodSeconds: ptr.To[int64](0),
+						InitContainers:                []corev1.Container{},
+						// Only Always restart policy is supported
+						Containers: []corev1.Container{
+							{
+								Name:            "sleep",
+								Image:           job.PreLoadPauseImage,
+								ImagePullPolicy: corev1.PullAlways,
+								Resources:       containerResources,
+							},
 						},
+						NodeSelector: job.PreLoadNodeLabels,
+						// Images are pulled in the node pools where the job pods are placed
+						Affinity:         nodePoolsAffinity(job.NodeWeights),
+						ImagePullSecrets: imagePullSecrets,
+						Tolerations:      tolerations,
 					},
-					NodeSelector: nodeSelectorLabels,
 				},
 			},
+		}
+
+		// Add the list of containers using images
+		for i, image := range images {
+			container := corev1.Container{
+				Name:            fmt.Sprintf("container-%d", i),
+				ImagePullPolicy: job.PreLoadImagePullPolicy,
+				Image:           image,
+				Command:         []string{"echo", fmt.Sprintf("init container-%d completed", i)},
+				Resources:       containerResources,
+			}
+			ds.Spec.Template.Spec.InitContainers = append(ds.Spec.Template.Spec.InitContainers, container)
+		}
+
+		log.Infof("Pre-load: Creating DaemonSet using images %v in namespace %s", images, preLoadNs)
+		_, err := clientSet.AppsV1().DaemonSets(preLoadNs).Create(context.TODO(), &ds, metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getPreLoadSecret fetches a secret referenced as <namespace>/<name>, the default namespace is used when not specified
+func getPreLoadSecret(clientSet kubernetes.Interface, secretRef string) (*corev1.Secret, error) {
+	namespace, name := corev1.NamespaceDefault, secretRef
+	if parts := strings.SplitN(secretRef, "/", 2); len(parts) == 2 {
+		namespace, name = parts[0], parts[1]
+	}
+	secret, err := clientSet.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting image pull secret %s: %v", secretRef, err)
+	}
+	return secret, nil
+}
+
+// toResourceList converts the given quantities into a resource list, quantities are validated when loading the configuration
+func toResourceList(quantities map[corev1.ResourceName]string) corev1.ResourceList {
+	if len(quantities) == 0 {
+		return nil
+	}
+	resourceList := make(corev1.ResourceList, len(quantities))
+	for name, value := range quantities {
+		resourceList[name] = resource.MustParse(value)
+	}
+	return resourceList
+}
+
+// createPreLoadSecret creates a copy of the given pull secret in the pre-load namespace
+func createPreLoadSecret(clientSet kubernetes.Interface, preLoadNs string, secret corev1.Secret) error {
+	preLoadSecret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: secret.Name,
 		},
+		Type:       secret.Type,
+		Data:       secret.Data,
+		StringData: secret.StringData,
 	}
+	log.Infof("Pre-load: Creating image pull secret %s in namespace %s", secret.Name, preLoadNs)
+	_, err := clientSet.CoreV1().Secrets(preLoadNs).Create(context.TODO(), &preLoadSecret, metav1.CreateOptions{})
+	if err != nil && !kerrors.IsAlreadyExists(err) {
+		return fmt.Errorf("error creating image pull secret %s: %v", secret.Name, err)
+	}
+	return nil
+}
 
-	// Add the list of containers using images
-	for i, image := range imageList {
-		container := corev1.Container{
-			Name:            fmt.Sprintf("container-%d", i),
-			ImagePullPolicy: corev1.PullAlways,
-			Image:           image,
-			Command:         []string{"echo", fmt.Sprintf("init container-%d completed", i)},
+// waitForDSs waits until all the preload DaemonSets are ready or the given timeout expires, returns the number of ready and desired pods
+func waitForDSs(ctx context.Context, clientSet kubernetes.Interface, preLoadNs string, timeout time.Duration) (int32, int32) {
+	var ready, desired int32
+	err := wait.PollUntilContextTimeout(ctx, time.Second, timeout, true, func(ctx context.Context) (done bool, err error) {
+		dsList, err := clientSet.AppsV1().DaemonSets(preLoadNs).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			log.Errorf("Pre-load: error listing DaemonSets in %s: %v", preLoadNs, err)
+			return false, nil
+		}
+		ready, desired = 0, 0
+		done = true
+		for _, ds := range dsList.Items {
+			ready += ds.Status.NumberReady
+			desired += ds.Status.DesiredNumberScheduled
+			if ds.Status.ObservedGeneration < ds.Generation || ds.Status.NumberReady != ds.Status.DesiredNumberScheduled {
+				done = false
+			}
 		}
-		ds.Spec.Template.Spec.InitContainers = append(ds.Spec.Template.Spec.InitContainers, container)
+		log.Debugf("Pre-load: %d/%d pods ready", ready, desired)
+		return done, nil
+	})
+	if ctx.Err() != nil {
+		log.Warnf("Pre-load: interrupted, %d/%d nodes completed", ready, desired)
+		return ready, desired
 	}
+	if err != nil {
+		log.Warnf("Pre-load: timeout waiting for DaemonSets, %d/%d nodes completed", ready, desired)
+		return ready, desired
+	}
+	log.Infof("Pre-load: %d/%d nodes completed", ready, desired)
+	return ready, desired
+}
 
-	log.Infof("Pre-load: Creating DaemonSet using images %v in namespace %s", imageList, preLoadNs)
-	_, err := clientSet.AppsV1().DaemonSets(preLoadNs).Create(context.TODO(), &ds, metav1.CreateOptions{})
+// reportPreLoadFailures logs the node and container statuses of the preload pods that didn't complete
+func reportPreLoadFailures(clientSet kubernetes.Interface, preLoadNs string) {
+	podList, err := clientSet.CoreV1().Pods(preLoadNs).List(context.TODO(), metav1.ListOptions{LabelSelector: "app=preload"})
 	if err != nil {
-		return err
+		log.Errorf("Pre-load: error listing pods in %s: %v", preLoadNs, err)
+		return
 	}
-	return nil
+	for _, pod := range podList.Items {
+		if isPreLoadPodReady(pod) {
+			continue
+		}
+		var statuses []string
+		for _, cs := range slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses) {
+			switch {
+			case cs.State.Waiting != nil:
+				statuses = append(statuses, fmt.Sprintf("%s(%s): %s", cs.Name, cs.Image, cs.State.Waiting.Reason))
+			case cs.State.Terminated != nil && cs.State.Terminated.ExitCode != 0:
+				statuses = append(statuses, fmt.Sprintf("%s(%s): %s", cs.Name, cs.Image, cs.State.Terminated.Reason))
+			}
+		}
+		nodeName := pod.Spec.NodeName
+		if nodeName == "" {
+			nodeName = "<unscheduled>"
+		}
+		log.Warnf("Pre-load: pod %s on node %s didn't complete, phase %s: %s", pod.Name, nodeName, pod.Status.Phase, strings.Join(statuses, ", "))
+	}
+}
+
+// isPreLoadPodReady returns true when the pod is running and all of its containers are ready
+func isPreLoadPodReady(pod corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if !cs.Ready {
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,47 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func TestPreLoadNamespace(t *testing.T) {
+	uuid := "2ac3e0c4-5e35-4f0c-9a39-5a0ea0b1c7e3"
+	tests := []struct {
+		name     string
+		jobName  string
+		expected string
+	}{
+		{"short job name", "density", "preload-density-" + uuid},
+		{"dotted job name", "cluster.density", "preload-cluster-density-" + uuid},
+		{"long job name", "cluster-density-v2-with-a-very-long-name", "preload-cluster-density-v2-" + uuid},
+		{"truncated at a dash", "cluster-density-v-2", "preload-cluster-density-v-" + uuid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ns := preLoadNamespace(tt.jobName, uuid)
+			if ns != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, ns)
+			}
+			if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+				t.Errorf("invalid namespace name %s: %s", ns, strings.Join(errs, ", "))
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// setPriorityClassName sets the given priority class in the pod spec of the object, objects without pod spec are left untouched.
+// The priority of the template is removed, the admission controller resolves it from the priority class and rejects pods where they don't match
+func setPriorityClassName(obj *unstructured.Unstructured, priorityClassName string) {
+	podSpecPath, ok := kindToPodSpecPath[obj.GetKind()]
+	if !ok {
+		return
+	}
+	unstructured.SetNestedField(obj.Object, priorityClassName, append(slices.Clone(podSpecPath), "priorityClassName")...)
+	unstructured.RemoveNestedField(obj.Object, append(slices.Clone(podSpecPath), "priority")...)
+}
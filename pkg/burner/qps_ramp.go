@@ -0,0 +1,66 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+type qpsSample struct {
+	timestamp time.Time
+	qps       float32
+}
+
+// startQPSRamp increases the rate limit of the job following its QPS ramp-up schedule,
+// the returned function stops the ramp and must be called once the job finishes
+func (ex *Executor) startQPSRamp(ctx context.Context) func() {
+	if ex.QPSRamp.EndQPS <= 0 {
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	qps := ex.QPSRamp.StartQPS
+	ex.setQPS(qps)
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(ex.QPSRamp.Interval)
+		defer ticker.Stop()
+		for qps < ex.QPSRamp.EndQPS {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				qps = min(qps+ex.QPSRamp.Step, ex.QPSRamp.EndQPS)
+				ex.setQPS(qps)
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// setQPS updates the rate limiters of the job and records the active QPS
+func (ex *Executor) setQPS(qps float32) {
+	log.Infof("Job %s: setting QPS to %v", ex.Name, qps)
+	ex.limiter.SetLimit(rate.Limit(qps))
+	ex.waitLimiter.SetLimit(rate.Limit(qps))
+	ex.stats.qpsSamples = append(ex.stats.qpsSamples, qpsSample{timestamp: time.Now().UTC(), qps: qps})
+}
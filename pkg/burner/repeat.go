@@ -0,0 +1,84 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/prometheus"
+	"github.com/prometheus/common/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// runRepeated runs the given function once, or in a loop until the repeatUntil condition of the job is met.
+// Returns the number of repetitions run
+func (ex *Executor) runRepeated(ctx context.Context, prometheusClients []*prometheus.Prometheus, run func(repetition int)) int {
+	if !ex.RepeatUntil.Enabled() {
+		run(0)
+		return 1
+	}
+	if ex.RepeatUntil.Alert != "" && len(prometheusClients) == 0 {
+		log.Fatalf("Job %s: repeatUntil alert requires a Prometheus endpoint", ex.Name)
+	}
+	start := time.Now()
+	repetition := 0
+	for ctx.Err() == nil {
+		log.Infof("Job %s: running repetition %d", ex.Name, repetition+1)
+		run(repetition)
+		repetition++
+		if ex.RepeatUntil.Duration > 0 && time.Since(start) >= ex.RepeatUntil.Duration {
+			log.Infof("Job %s: repeatUntil duration of %v reached after %d repetitions", ex.Name, ex.RepeatUntil.Duration, repetition)
+			break
+		}
+		if ex.RepeatUntil.Alert != "" && alertFiring(ex.RepeatUntil.Alert, prometheusClients) {
+			log.Infof("Job %s: repeatUntil alert fired after %d repetitions", ex.Name, repetition)
+			break
+		}
+		if ex.RepeatUntil.Delay > 0 {
+			log.Infof("Job %s: sleeping for %v before the next repetition", ex.Name, ex.RepeatUntil.Delay)
+			if !creationPause.sleep(ctx, ex.RepeatUntil.Delay) {
+				break
+			}
+		}
+	}
+	return repetition
+}
+
+// alertFiring evaluates the given expression in the given Prometheus endpoints, returning true
+// when any of them returns a sample with a value other than 0
+func alertFiring(expr string, prometheusClients []*prometheus.Prometheus) bool {
+	for _, prometheusClient := range prometheusClients {
+		log.Debugf("Evaluating repeatUntil expression '%s' in %s", expr, prometheusClient.Endpoint)
+		v, err := prometheusClient.Query(expr, time.Now().UTC())
+		if err != nil {
+			log.Warnf("Error performing query %s: %s", expr, err)
+			continue
+		}
+		switch result := v.(type) {
+		case model.Vector:
+			for _, sample := range result {
+				if sample.Value != 0 {
+					return true
+				}
+			}
+		case *model.Scalar:
+			if result.Value != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
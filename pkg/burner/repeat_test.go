@@ -0,0 +1,40 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+)
+
+func TestRunRepeatedDelay(t *testing.T) {
+	ex := &Executor{Job: config.Job{Name: "test", RepeatUntil: config.RepeatUntil{Duration: time.Hour, Delay: time.Hour}}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	start := time.Now()
+	repetitions := ex.runRepeated(ctx, nil, func(int) {
+		// The delay until the next repetition is interrupted by the cancellation
+		time.AfterFunc(10*time.Millisecond, cancel)
+	})
+	if repetitions != 1 {
+		t.Errorf("expected 1 repetition, got %d", repetitions)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("the delay between repetitions wasn't interrupted by the context")
+	}
+}
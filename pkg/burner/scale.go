@@ -0,0 +1,171 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	mmetrics "github.com/kube-burner/kube-burner/pkg/measurements/metrics"
+	log "github.com/sirupsen/logrus"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	scaleUp   = "ScaleUp"
+	scaleDown = "ScaleDown"
+)
+
+// scaleSample holds the result of a scale operation, the latency is the time since the scale request until the controller reconciles the new replica count
+type scaleSample struct {
+	Timestamp    time.Time `json:"timestamp"`
+	Kind         string    `json:"kind"`
+	Namespace    string    `json:"namespace"`
+	Name         string    `json:"name"`
+	Iteration    int       `json:"iteration"`
+	Direction    string    `json:"direction"`
+	FromReplicas int32     `json:"fromReplicas"`
+	ToReplicas   int32     `json:"toReplicas"`
+	Latency      int64     `json:"latency"`
+	Error        string    `json:"error,omitempty"`
+}
+
+func (ex *Executor) setupScaleJob(mapper meta.RESTMapper) {
+	log.Debugf("Preparing scale job: %s", ex.Name)
+	ex.itemHandler = scaleHandler
+	// Each iteration waits for the scale operations of the previous one to be reconciled
+	ex.ExecutionMode = config.ExecutionModeSequential
+	for _, o := range ex.Objects {
+		obj := newObject(o, mapper, "apps/v1", ex.embedCfg)
+		if obj.gvr.Group != "apps" || (obj.gvr.Resource != "deployments" && obj.gvr.Resource != "statefulsets") {
+			log.Fatalf("Job %s: scale jobs only support Deployments and StatefulSets, found %s", ex.Name, o.Kind)
+		}
+		log.Infof("Job %s: %s %s with selector %s", ex.Name, ex.JobType, o.Kind, labels.Set(o.LabelSelector))
+		ex.objects = append(ex.objects, obj)
+	}
+	log.Infof("Job %s: %d iterations", ex.Name, ex.JobIterations)
+}
+
+// scaleHandler updates the scale subresource of the item and waits for its controller to reconcile the new replica count
+func scaleHandler(ex *Executor, obj *object, item unstructured.Unstructured, iteration int, objectTimeUTC int64, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ns, name := item.GetNamespace(), item.GetName()
+	ex.limiter.Wait(context.TODO())
+	scale, err := ex.getScale(obj, ns, name)
+	if err != nil {
+		log.Errorf("Error getting scale of %s/%s in namespace %s: %v", item.GetKind(), name, ns, err)
+		return
+	}
+	replicas := max(scale.Spec.Replicas+obj.ScaleDelta, 0)
+	if obj.ScaleReplicas != nil {
+		replicas = *obj.ScaleReplicas
+	}
+	if replicas == scale.Spec.Replicas {
+		log.Debugf("%s/%s in namespace %s already has %d replicas", item.GetKind(), name, ns, replicas)
+		return
+	}
+	sample := scaleSample{
+		Timestamp:    time.Now().UTC(),
+		Kind:         item.GetKind(),
+		Namespace:    ns,
+		Name:         name,
+		Iteration:    iteration,
+		Direction:    scaleUp,
+		FromReplicas: scale.Spec.Replicas,
+		ToReplicas:   replicas,
+	}
+	if replicas < scale.Spec.Replicas {
+		sample.Direction = scaleDown
+	}
+	log.Debugf("Scaling %s/%s in namespace %s from %d to %d replicas", item.GetKind(), name, ns, scale.Spec.Replicas, replicas)
+	scale.Spec.Replicas = replicas
+	ex.limiter.Wait(context.TODO())
+	start := time.Now()
+	if err = ex.updateScale(obj, ns, scale); err != nil {
+		log.Errorf("Error scaling %s/%s in namespace %s: %v", item.GetKind(), name, ns, err)
+		sample.Error = err.Error()
+		ex.stats.addScaleSample(sample)
+		return
+	}
+	err = wait.PollUntilContextTimeout(context.TODO(), time.Second, ex.MaxWaitTimeout, true, func(ctx context.Context) (bool, error) {
+		uns, err := ex.dynamicClient.Resource(obj.gvr).Namespace(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			log.Errorf("Error getting %s/%s in namespace %s: %v", item.GetKind(), name, ns, err)
+			return false, nil
+		}
+		return scaleReconciled(uns, replicas), nil
+	})
+	sample.Latency = time.Since(start).Milliseconds()
+	if err != nil {
+		log.Errorf("Timeout waiting for %s/%s in namespace %s to reconcile %d replicas", item.GetKind(), name, ns, replicas)
+		sample.Error = err.Error()
+	}
+	ex.stats.addScaleSample(sample)
+}
+
+func (ex *Executor) getScale(obj *object, ns, name string) (*autoscalingv1.Scale, error) {
+	if obj.gvr.Resource == "statefulsets" {
+		return ex.clientSet.AppsV1().StatefulSets(ns).GetScale(context.TODO(), name, metav1.GetOptions{})
+	}
+	return ex.clientSet.AppsV1().Deployments(ns).GetScale(context.TODO(), name, metav1.GetOptions{})
+}
+
+func (ex *Executor) updateScale(obj *object, ns string, scale *autoscalingv1.Scale) error {
+	var err error
+	if obj.gvr.Resource == "statefulsets" {
+		_, err = ex.clientSet.AppsV1().StatefulSets(ns).UpdateScale(context.TODO(), scale.Name, scale, metav1.UpdateOptions{})
+	} else {
+		_, err = ex.clientSet.AppsV1().Deployments(ns).UpdateScale(context.TODO(), scale.Name, scale, metav1.UpdateOptions{})
+	}
+	return err
+}
+
+// scaleReconciled returns true when the controller observed the latest generation of the object and its replicas, all of them ready, match the given count
+func scaleReconciled(uns *unstructured.Unstructured, replicas int32) bool {
+	observedGeneration, _, _ := unstructured.NestedInt64(uns.Object, "status", "observedGeneration")
+	statusReplicas, _, _ := unstructured.NestedInt64(uns.Object, "status", "replicas")
+	readyReplicas, _, _ := unstructured.NestedInt64(uns.Object, "status", "readyReplicas")
+	return observedGeneration >= uns.GetGeneration() && statusReplicas == int64(replicas) && readyReplicas == int64(replicas)
+}
+
+func (s *jobStats) addScaleSample(sample scaleSample) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scaleSamples = append(s.scaleSamples, sample)
+}
+
+// scaleLatencySummary returns the quantiles of the scale-up and scale-down latencies of the reconciled scale operations
+func (s *jobStats) scaleLatencySummary() []mmetrics.LatencyQuantiles {
+	var summaries []mmetrics.LatencyQuantiles
+	latencies := map[string][]float64{}
+	for _, sample := range s.scaleSamples {
+		if sample.Error == "" {
+			latencies[sample.Direction] = append(latencies[sample.Direction], float64(sample.Latency))
+		}
+	}
+	for _, direction := range []string{scaleUp, scaleDown} {
+		if len(latencies[direction]) > 0 {
+			summaries = append(summaries, mmetrics.NewLatencySummary(latencies[direction], direction))
+		}
+	}
+	return summaries
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// liveObjectsSample number of objects of the job alive at a given time
+type liveObjectsSample struct {
+	timestamp time.Time
+	objects   int
+}
+
+// runSteadyStateChurn churns the job keeping its live object count constant, each churned iteration is re-created
+// as soon as its objects are deleted, instead of deleting all the churned iterations first. The live object count
+// is sampled after every re-created iteration
+func (ex *Executor) runSteadyStateChurn(ctx context.Context) {
+	numToChurn := int(math.Max(float64(ex.ChurnPercent*ex.JobIterations/100), 1))
+	now := time.Now().UTC()
+	cyclesCount := 0
+	pausedAtStart := creationPause.pausedTime()
+	ex.sampleLiveObjects(ctx)
+	defer ex.logLiveObjects()
+	for {
+		creationPause.wait(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(now)-(creationPause.pausedTime()-pausedAtStart) >= ex.ChurnDuration {
+			log.Info("Churn job complete")
+			return
+		}
+		if ex.ChurnCycles > 0 && cyclesCount >= ex.ChurnCycles {
+			log.Infof("Reached specified number of churn cycles (%d), stopping churn job", ex.ChurnCycles)
+			return
+		}
+		iterations := ex.churnIterations(cyclesCount, numToChurn)
+		log.Infof("Churn cycle %d: churning %d iterations in steady state using the %s deletion strategy", cyclesCount+1, len(iterations), ex.ChurnDeletionStrategy)
+		for _, i := range iterations {
+			if ctx.Err() != nil {
+				return
+			}
+			CleanupIterations(ctx, *ex, i, i+1, ex.generateNamespace(i))
+			ex.RunCreateJob(ctx, i, i+1, &[]string{})
+			ex.sampleLiveObjects(ctx)
+		}
+		log.Infof("Sleeping for %v", ex.ChurnDelay)
+		if !creationPause.sleep(ctx, ex.ChurnDelay) {
+			return
+		}
+		cyclesCount++
+	}
+}
+
+// sampleLiveObjects records the number of objects of the job currently alive, matching them by the job and object index labels
+func (ex *Executor) sampleLiveObjects(ctx context.Context) {
+	var liveObjects int
+	for objectIndex, obj := range ex.objects {
+		labelSelector := fmt.Sprintf("kube-burner-job=%s,kube-burner-uuid=%s,kube-burner-index=%s", ex.Name, ex.uuid, strconv.Itoa(objectIndex))
+		itemList, err := ex.dynamicClient.Resource(obj.gvr).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+		if err != nil {
+			log.Errorf("Error listing %s to count live objects: %v", obj.gvr.Resource, err)
+			return
+		}
+		liveObjects += len(itemList.Items)
+	}
+	log.Debugf("%s: %d live objects", ex.Name, liveObjects)
+	ex.stats.mu.Lock()
+	defer ex.stats.mu.Unlock()
+	ex.stats.liveObjectsSamples = append(ex.stats.liveObjectsSamples, liveObjectsSample{timestamp: time.Now().UTC(), objects: liveObjects})
+}
+
+// logLiveObjects logs the range of the live object count sampled during the steady-state churn
+func (ex *Executor) logLiveObjects() {
+	ex.stats.mu.Lock()
+	defer ex.stats.mu.Unlock()
+	if len(ex.stats.liveObjectsSamples) == 0 {
+		return
+	}
+	counts := make([]int, 0, len(ex.stats.liveObjectsSamples))
+	for _, sample := range ex.stats.liveObjectsSamples {
+		counts = append(counts, sample.objects)
+	}
+	log.Infof("%s: live objects during steady-state churn, initial: %d min: %d max: %d", ex.Name, counts[0], slices.Min(counts), slices.Max(counts))
+}
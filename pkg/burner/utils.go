@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -117,6 +118,7 @@ func (ex *Executor) Verify() bool {
 	var replicas int
 	success := true
 	log.Info("Verifying created objects")
+	selections := ex.weightedSelections()
 	for objectIndex, obj := range ex.objects {
 		listOptions := metav1.ListOptions{
 			LabelSelector: fmt.Sprintf("kube-burner-uuid=%s,kube-burner-runid=%s,kube-burner-job=%s,kube-burner-index=%d", ex.uuid, ex.runid, ex.Name, objectIndex),
@@ -147,6 +149,8 @@ func (ex *Executor) Verify() bool {
 		var objectsExpected int
 		if obj.RunOnce {
 			objectsExpected = obj.Replicas
+		} else if obj.Weight > 0 {
+			objectsExpected = obj.Replicas * selections[objectIndex]
 		} else {
 			objectsExpected = obj.Replicas * ex.JobIterations
 		}
@@ -182,6 +186,11 @@ func newRESTMapper(discoveryClient *discovery.DiscoveryClient) meta.RESTMapper {
 }
 
 func (ex *Executor) RunJob(ctx context.Context) {
+	// List jobs don't operate on existing objects
+	if ex.JobType == config.ListJob {
+		ex.runList(ctx)
+		return
+	}
 	switch ex.ExecutionMode {
 	case config.ExecutionModeParallel:
 		ex.runParallel(ctx)
@@ -193,18 +202,20 @@ func (ex *Executor) RunJob(ctx context.Context) {
 func (ex *Executor) getItemListForObject(obj *object) (*unstructured.UnstructuredList, error) {
 	var itemList *unstructured.UnstructuredList
 	labelSelector := labels.Set(obj.LabelSelector).String()
+	fieldSelector := fields.Set(obj.FieldSelector).String()
 	listOptions := metav1.ListOptions{
 		LabelSelector: labelSelector,
+		FieldSelector: fieldSelector,
 	}
 
 	// Try to find the list of resources by GroupVersionResource.
 	err := util.RetryWithExponentialBackOff(func() (done bool, err error) {
 		itemList, err = ex.dynamicClient.Resource(obj.gvr).List(context.TODO(), listOptions)
 		if err != nil {
-			log.Errorf("Error found listing %s labeled with %s: %s", obj.gvr.Resource, labelSelector, err)
+			log.Errorf("Error found listing %s labeled with %s and field selector %s: %s", obj.gvr.Resource, labelSelector, fieldSelector, err)
 			return false, nil
 		}
-		log.Infof("Found %d %s with selector %s; patching them", len(itemList.Items), obj.gvr.Resource, labelSelector)
+		log.Infof("Found %d %s with selector %s and field selector %s; patching them", len(itemList.Items), obj.gvr.Resource, labelSelector, fieldSelector)
 		return true, nil
 	}, 1*time.Second, 3, 0, ex.MaxWaitTimeout)
 	if err != nil {
@@ -216,6 +227,7 @@ func (ex *Executor) getItemListForObject(obj *object) (*unstructured.Unstructure
 func (ex *Executor) runSequential(ctx context.Context) {
 	for i := range ex.JobIterations {
 		for _, obj := range ex.objects {
+			creationPause.wait(ctx)
 			if ctx.Err() != nil {
 				return
 			}
@@ -276,6 +288,7 @@ func (ex *Executor) runParallel(ctx context.Context) {
 			continue
 		}
 		for j := range ex.JobIterations {
+			creationPause.wait(ctx)
 			objectTimeUTC := time.Now().UTC().Unix()
 			for _, item := range itemList.Items {
 				wg.Add(1)
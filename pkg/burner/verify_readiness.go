@@ -0,0 +1,129 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/util"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var (
+	podReadyCondition = ConditionCheckConfig{
+		conditionType:        conditionTypeReady,
+		conditionCheckParams: []ConditionCheckParam{conditionCheckParamStatusTrue},
+	}
+	podReadyStatusPaths            = append([]config.StatusPath{{Key: ".phase", Value: "Running"}}, podReadyCondition.toStatusPaths(0)...)
+	pvcReadyStatusPaths            = []config.StatusPath{{Key: ".phase", Value: "Bound"}}
+	buildReadyStatusPaths          = []config.StatusPath{{Key: ".phase", Value: "Complete"}}
+	volumeSnapshotReadyStatusPaths = []config.StatusPath{{Key: ".readyToUse | tostring | ascii_downcase", Value: "true"}}
+)
+
+// verifyReadiness performs a point-in-time check of the ready condition of every object created by the job
+// and returns the number of objects that didn't satisfy it, broken down by kind
+func (ex *Executor) verifyReadiness() map[string]int {
+	notReady := make(map[string]int)
+	log.Info("Verifying readiness of created objects")
+	for objectIndex, obj := range ex.objects {
+		listOptions := metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("kube-burner-uuid=%s,kube-burner-runid=%s,kube-burner-job=%s,kube-burner-index=%d", ex.uuid, ex.runid, ex.Name, objectIndex),
+			Limit:         objectLimit,
+		}
+		err := util.RetryWithExponentialBackOff(func() (done bool, err error) {
+			var count int
+			for {
+				objList, err := ex.dynamicClient.Resource(obj.gvr).Namespace(metav1.NamespaceAll).List(context.TODO(), listOptions)
+				if err != nil {
+					log.Errorf("Error listing %s: %v", obj.Kind, err)
+					return false, nil
+				}
+				for _, item := range objList.Items {
+					ready, err := objectReady(obj, item)
+					if err != nil {
+						log.Errorf("Error checking readiness of %s/%s: %v", item.GetKind(), item.GetName(), err)
+					}
+					if !ready {
+						log.Debugf("%s %s/%s is not ready", obj.Kind, item.GetNamespace(), item.GetName())
+						count++
+					}
+				}
+				listOptions.Continue = objList.GetContinue()
+				if listOptions.Continue == "" {
+					break
+				}
+			}
+			if count > 0 {
+				notReady[obj.Kind] += count
+			}
+			return true, nil
+		}, 1*time.Second, 3, 0, 1*time.Minute)
+		if err != nil {
+			log.Errorf("Error verifying readiness of %s: %v", obj.Kind, err)
+		}
+	}
+	for kind, count := range notReady {
+		log.Errorf("%d %s objects are not ready", count, kind)
+	}
+	return notReady
+}
+
+// objectReady returns whether the given object satisfies its ready condition, using the same
+// criteria applied when waiting for it. Kinds without a known ready condition are considered ready
+func objectReady(obj *object, item unstructured.Unstructured) (bool, error) {
+	if obj.WaitOptions.Kind == "" {
+		if len(obj.WaitOptions.CustomStatusPaths) > 0 {
+			return statusPathsVerified(item, obj.WaitOptions.CustomStatusPaths)
+		}
+		if obj.WaitOptions.WaitCondition != nil {
+			return statusPathsVerified(item, waitConditionToStatusPaths(*obj.WaitOptions.WaitCondition))
+		}
+	}
+	if waiterConditionPath, ok := waitersConditionPaths[obj.Kind]; ok {
+		return statusPathsVerified(item, waiterConditionPath.toStatusPaths(0))
+	}
+	switch obj.Kind {
+	case Deployment, ReplicaSet, ReplicationController, StatefulSet, DaemonSet, VirtualMachineInstanceReplicaSet:
+		waitPath := waitStatusMap[obj.Kind]
+		replicas, _, err := unstructured.NestedFieldCopy(item.Object, waitPath.expectedReplicasPath...)
+		if err != nil {
+			return false, err
+		}
+		readyReplicas, found, err := unstructured.NestedFieldCopy(item.Object, waitPath.readyReplicasPath...)
+		if err != nil {
+			return false, err
+		}
+		// The ready replicas field is omitted by the API when there're no ready replicas
+		if !found {
+			readyReplicas = int64(0)
+		}
+		return reflect.DeepEqual(replicas, readyReplicas), nil
+	case Pod:
+		return statusPathsVerified(item, podReadyStatusPaths)
+	case PersistentVolumeClaim:
+		return statusPathsVerified(item, pvcReadyStatusPaths)
+	case Build:
+		return statusPathsVerified(item, buildReadyStatusPaths)
+	case VolumeSnapshot:
+		return statusPathsVerified(item, volumeSnapshotReadyStatusPaths)
+	}
+	return true, nil
+}
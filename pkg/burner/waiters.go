@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/itchyny/gojq"
@@ -85,6 +86,9 @@ func (ex *Executor) waitForObject(ns string, obj *object) {
 		ns = obj.namespace
 	}
 	var err error
+	if len(obj.WaitOptions.CustomStatusPaths) == 0 && obj.WaitOptions.WaitCondition != nil {
+		obj.WaitOptions.CustomStatusPaths = waitConditionToStatusPaths(*obj.WaitOptions.WaitCondition)
+	}
 	if len(obj.WaitOptions.CustomStatusPaths) > 0 {
 		err = ex.verifyCondition(ns, *obj)
 	} else {
@@ -113,18 +117,102 @@ func (ex *Executor) waitForObject(ns string, obj *object) {
 	}
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
+			// Objects exceeding the object wait timeout are accounted, and the remaining objects are still waited
+			if ex.ObjectWaitTimeout > 0 {
+				log.Errorf("Timeout occurred while waiting for %s in namespace %s", obj.Kind, ns)
+				ex.accountWaitedObjects(ns, obj, true)
+				return
+			}
 			log.Fatalf("Timeout occurred while waiting for objects in namespace %s: %v", ns, err)
 		} else {
 			log.Fatalf("Error waiting for objects in namespace %s: %v", ns, err)
 		}
 	}
+	if ex.ObjectWaitTimeout > 0 {
+		ex.accountWaitedObjects(ns, obj, false)
+	}
 	if obj.namespace != "" || obj.RunOnce {
 		obj.ready = true
 	}
 }
 
+// waitTimeout returns the maximum wait period of each object, maxWaitTimeout when objectWaitTimeout is not set
+func (ex *Executor) waitTimeout() time.Duration {
+	if ex.ObjectWaitTimeout > 0 {
+		return ex.ObjectWaitTimeout
+	}
+	return ex.MaxWaitTimeout
+}
+
+// waitTimeoutsError returns an error when any object exceeded the object wait timeout
+func (ex *Executor) waitTimeoutsError() error {
+	timedOut := ex.stats.waitTimedOutObjects.Load()
+	if timedOut == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d objects exceeded the object wait timeout of %v, %d objects succeeded", timedOut, ex.ObjectWaitTimeout, ex.stats.waitSucceededObjects.Load())
+}
+
+// waitTimeoutsRC returns the return code of the objects exceeding the object wait timeout, flagging the job as
+// partially failed while the percentage of timed out objects doesn't exceed partialFailureThreshold
+func (ex *Executor) waitTimeoutsRC() int {
+	timedOut := ex.stats.waitTimedOutObjects.Load()
+	total := timedOut + ex.stats.waitSucceededObjects.Load()
+	if ex.PartialFailureThreshold == 0 || total == 0 {
+		return rcNotReady
+	}
+	timedOutPercent := float64(timedOut) * 100 / float64(total)
+	if timedOutPercent > ex.PartialFailureThreshold {
+		log.Errorf("%.2f%% of the objects timed out, higher than the partialFailureThreshold of %v%%", timedOutPercent, ex.PartialFailureThreshold)
+		return rcNotReady
+	}
+	log.Warnf("%.2f%% of the objects timed out, within the partialFailureThreshold of %v%%: job partially failed", timedOutPercent, ex.PartialFailureThreshold)
+	return rcPartialFailure
+}
+
+// accountWaitedObjects tallies the objects waited in the given namespace. When the wait timed out,
+// only the objects not satisfying their ready condition are accounted as timed out
+func (ex *Executor) accountWaitedObjects(ns string, obj *object, timedOut bool) {
+	listOptions := metav1.ListOptions{
+		LabelSelector: labels.Set(obj.WaitOptions.LabelSelector).String(),
+		Limit:         objectLimit,
+	}
+	for {
+		var objs *unstructured.UnstructuredList
+		var err error
+		ex.limiter.Wait(context.TODO())
+		if obj.namespaced {
+			objs, err = ex.dynamicClient.Resource(obj.gvr).Namespace(ns).List(context.TODO(), listOptions)
+		} else {
+			objs, err = ex.dynamicClient.Resource(obj.gvr).List(context.TODO(), listOptions)
+		}
+		if err != nil {
+			log.Errorf("Error listing %s to account the waited objects: %v", obj.Kind, err)
+			return
+		}
+		for _, item := range objs.Items {
+			ready := !timedOut
+			if timedOut {
+				if ready, err = objectReady(obj, item); err != nil {
+					log.Errorf("Error checking readiness of %s/%s: %v", item.GetKind(), item.GetName(), err)
+				}
+			}
+			if ready {
+				ex.stats.waitSucceededObjects.Add(1)
+			} else {
+				log.Errorf("%s %s/%s timed out after %v", obj.Kind, item.GetNamespace(), item.GetName(), ex.ObjectWaitTimeout)
+				ex.stats.waitTimedOutObjects.Add(1)
+			}
+		}
+		listOptions.Continue = objs.GetContinue()
+		if listOptions.Continue == "" {
+			return
+		}
+	}
+}
+
 func (ex *Executor) waitForReplicas(ns string, obj object, waitPath statusPath) error {
-	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, ex.MaxWaitTimeout, true, func(ctx context.Context) (done bool, err error) {
+	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, ex.waitTimeout(), true, func(ctx context.Context) (done bool, err error) {
 		ex.waitLimiter.Wait(context.TODO())
 		resources, err := ex.dynamicClient.Resource(obj.gvr).Namespace(ns).List(context.TODO(), metav1.ListOptions{
 			LabelSelector: labels.Set(obj.WaitOptions.LabelSelector).String(),
@@ -153,7 +241,7 @@ func (ex *Executor) waitForReplicas(ns string, obj object, waitPath statusPath)
 }
 
 func (ex *Executor) waitForPVC(ns string, obj object) error {
-	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, ex.MaxWaitTimeout, true, func(ctx context.Context) (done bool, err error) {
+	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, ex.waitTimeout(), true, func(ctx context.Context) (done bool, err error) {
 		ex.limiter.Wait(context.TODO())
 		pvcs, err := ex.clientSet.CoreV1().PersistentVolumeClaims(ns).List(context.TODO(), metav1.ListOptions{
 			LabelSelector: labels.Set(obj.WaitOptions.LabelSelector).String(),
@@ -174,7 +262,7 @@ func (ex *Executor) waitForPVC(ns string, obj object) error {
 }
 
 func (ex *Executor) waitForPod(ns string, obj object) error {
-	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, ex.MaxWaitTimeout, true, func(ctx context.Context) (done bool, err error) {
+	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, ex.waitTimeout(), true, func(ctx context.Context) (done bool, err error) {
 		// We need to paginate these requests to ensure we don't miss any pods
 		listOptions := metav1.ListOptions{
 			Limit:         1000,
@@ -210,7 +298,7 @@ func (ex *Executor) waitForPod(ns string, obj object) error {
 func (ex *Executor) waitForBuild(ns string, obj object) error {
 	buildStatus := []string{"New", "Pending", "Running"}
 	var build types.UnstructuredContent
-	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, ex.MaxWaitTimeout, true, func(ctx context.Context) (done bool, err error) {
+	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, ex.waitTimeout(), true, func(ctx context.Context) (done bool, err error) {
 		ex.limiter.Wait(context.TODO())
 		builds, err := ex.dynamicClient.Resource(obj.gvr).Namespace(ns).List(context.TODO(), metav1.ListOptions{
 			LabelSelector: labels.Set(obj.WaitOptions.LabelSelector).String(),
@@ -242,7 +330,7 @@ func (ex *Executor) waitForBuild(ns string, obj object) error {
 }
 
 func (ex *Executor) verifyCondition(ns string, obj object) error {
-	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, ex.MaxWaitTimeout, true, func(ctx context.Context) (done bool, err error) {
+	err := wait.PollUntilContextTimeout(context.TODO(), time.Second, ex.waitTimeout(), true, func(ctx context.Context) (done bool, err error) {
 		var objs *unstructured.UnstructuredList
 		ex.limiter.Wait(context.TODO())
 		if obj.namespaced {
@@ -262,51 +350,17 @@ func (ex *Executor) verifyCondition(ns string, obj object) error {
 			}
 			return false, nil
 		}
-	VERIFY:
 		for _, item := range objs.Items {
-			isVerified := true
-			for _, statusPath := range obj.WaitOptions.CustomStatusPaths {
-				status, found, err := unstructured.NestedMap(item.Object, "status")
-				if err != nil || !found {
-					log.Errorf("Error extracting or finding status in object %s/%s: %v", item.GetKind(), item.GetName(), err)
-					return false, err
-				}
-				isStatusValid := false
-				if len(status) != 0 {
-					// Compile and execute the jq query
-					query, err := gojq.Parse(statusPath.Key)
-					if err != nil {
-						log.Errorf("Error parsing jq path: %s", statusPath.Key)
-						return false, err
-					}
-					iter := query.Run(status)
-					for {
-						v, ok := iter.Next()
-						if !ok {
-							break
-						}
-						if err, ok := v.(error); ok {
-							log.Warnf("Error evaluating jq path: [%s]: %s", statusPath.Key, err)
-							break
-						}
-						if v == statusPath.Value {
-							isStatusValid = true
-							break
-						}
-					}
-				}
-				isVerified = isVerified && isStatusValid
-			}
 			if obj.namespaced {
 				log.Debugf("Waiting for %s in ns %s to be ready", obj.gvr.Resource, ns)
 			} else {
 				log.Debugf("Waiting for %s to be ready", obj.gvr.Resource)
 			}
-			if isVerified {
-				log.Debugf("Status verified for object %s/%s", item.GetKind(), item.GetName())
-				continue VERIFY
+			isVerified, err := statusPathsVerified(item, obj.WaitOptions.CustomStatusPaths)
+			if err != nil || !isVerified {
+				return false, err
 			}
-			return false, err
+			log.Debugf("Status verified for object %s/%s", item.GetKind(), item.GetName())
 		}
 		return true, nil
 	})
@@ -324,3 +378,41 @@ func (ex *Executor) waitForVolumeSnapshot(ns string, obj object) error {
 	}
 	return ex.verifyCondition(ns, obj)
 }
+
+// statusPathsVerified evaluates the given jq status paths against the status of the object
+func statusPathsVerified(item unstructured.Unstructured, statusPaths []config.StatusPath) (bool, error) {
+	isVerified := true
+	for _, statusPath := range statusPaths {
+		status, found, err := unstructured.NestedMap(item.Object, "status")
+		if err != nil || !found {
+			log.Errorf("Error extracting or finding status in object %s/%s: %v", item.GetKind(), item.GetName(), err)
+			return false, err
+		}
+		isStatusValid := false
+		if len(status) != 0 {
+			// Compile and execute the jq query
+			query, err := gojq.Parse(statusPath.Key)
+			if err != nil {
+				log.Errorf("Error parsing jq path: %s", statusPath.Key)
+				return false, err
+			}
+			iter := query.Run(status)
+			for {
+				v, ok := iter.Next()
+				if !ok {
+					break
+				}
+				if err, ok := v.(error); ok {
+					log.Warnf("Error evaluating jq path: [%s]: %s", statusPath.Key, err)
+					break
+				}
+				if v == statusPath.Value {
+					isStatusValid = true
+					break
+				}
+			}
+		}
+		isVerified = isVerified && isStatusValid
+	}
+	return isVerified, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// runWarmup creates the warmup iterations of the job and deletes them afterwards, it's run before the job measurements
+// and metrics collection start, so neither its objects nor its latencies are accounted by the measured phase
+func (ex *Executor) runWarmup(ctx context.Context) {
+	start := time.Now().UTC()
+	labelSelector := fmt.Sprintf("kube-burner-job=%s", ex.Name)
+	log.Infof("🌡️ Starting warmup phase of job %s: %d iterations", ex.Name, ex.WarmupIterations)
+	if ex.Cleanup {
+		garbageCollectJob(context.TODO(), *ex, labelSelector, nil)
+	}
+	ex.RunCreateJob(ctx, 0, ex.WarmupIterations, &[]string{})
+	if ctx.Err() != nil {
+		return
+	}
+	log.Infof("Deleting warmup objects of job %s", ex.Name)
+	garbageCollectJob(ctx, *ex, labelSelector, nil)
+	// Statistics collected during the warmup aren't reported
+	ex.stats = &jobStats{}
+	ex.jitter.reset()
+	log.Infof("🌡️ Warmup phase of job %s finished after %v, starting measured phase", ex.Name, time.Since(start).Round(time.Second))
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package burner
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// weightedObject returns the index of the weighted object instantiated in the given iteration, -1 when the job
+// has no weighted objects. The object is sampled from the weights using a hash of the run UUID and the iteration,
+// so the same object is selected when the iteration is re-created, i.e. by churn
+func (ex *Executor) weightedObject(iteration int) int {
+	if ex.totalWeight == 0 {
+		return -1
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s-%d", ex.uuid, iteration)
+	pick := int(h.Sum64() % uint64(ex.totalWeight))
+	for objectIndex, obj := range ex.objects {
+		if obj.Weight == 0 {
+			continue
+		}
+		if pick < obj.Weight {
+			return objectIndex
+		}
+		pick -= obj.Weight
+	}
+	return -1
+}
+
+// weightedSelections returns the number of iterations each weighted object was selected in, indexed by object
+func (ex *Executor) weightedSelections() map[int]int {
+	selections := make(map[int]int)
+	if ex.totalWeight == 0 {
+		return selections
+	}
+	for i := 0; i < ex.JobIterations; i++ {
+		selections[ex.weightedObject(i)]++
+	}
+	return selections
+}
+
+// templateMix logs the realized distribution of the weighted objects, and returns the number of iterations
+// each object template was selected in, nil when the job has no weighted objects
+func (ex *Executor) templateMix() map[string]int {
+	if ex.totalWeight == 0 {
+		return nil
+	}
+	mix := make(map[string]int)
+	selections := ex.weightedSelections()
+	for objectIndex, obj := range ex.objects {
+		if obj.Weight == 0 {
+			continue
+		}
+		selected := selections[objectIndex]
+		log.Infof("%s: object template %s selected in %d iterations (%.1f%%), requested %.1f%%", ex.Name, obj.ObjectTemplate, selected,
+			float64(selected)/float64(ex.JobIterations)*100, float64(obj.Weight)/float64(ex.totalWeight)*100)
+		mix[obj.ObjectTemplate] += selected
+	}
+	return mix
+}
@@ -0,0 +1,147 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"cmp"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// APIRequestCount number of API requests issued with a verb against a resource
+type APIRequestCount struct {
+	Verb     string `json:"verb"`
+	Resource string `json:"resource"`
+	Count    int64  `json:"count"`
+}
+
+type apiRequestKey struct {
+	verb     string
+	resource string
+}
+
+// APIRequestSnapshot number of API requests issued up to a point in time by verb and resource
+type APIRequestSnapshot map[apiRequestKey]int64
+
+// apiRequestCounter tallies the requests sent by the clients built from the provider's rest config
+type apiRequestCounter struct {
+	mu     sync.Mutex
+	counts APIRequestSnapshot
+}
+
+type apiRequestTransport struct {
+	http.RoundTripper
+	counter *apiRequestCounter
+}
+
+func (t apiRequestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.counter.add(requestVerb(req), requestResource(req.URL.Path))
+	return t.RoundTripper.RoundTrip(req)
+}
+
+func (c *apiRequestCounter) wrap(rt http.RoundTripper) http.RoundTripper {
+	return apiRequestTransport{RoundTripper: rt, counter: c}
+}
+
+func (c *apiRequestCounter) add(verb, resource string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts[apiRequestKey{verb: verb, resource: resource}]++
+}
+
+// APIRequests returns the number of API requests issued so far by verb and resource
+func (p *KubeClientProvider) APIRequests() APIRequestSnapshot {
+	p.apiRequests.mu.Lock()
+	defer p.apiRequests.mu.Unlock()
+	return maps.Clone(p.apiRequests.counts)
+}
+
+// APIRequestsSince returns the API requests issued since the given APIRequests snapshot, sorted by verb and resource
+func (p *KubeClientProvider) APIRequestsSince(snapshot APIRequestSnapshot) []APIRequestCount {
+	var requests []APIRequestCount
+	for k, v := range p.APIRequests() {
+		if count := v - snapshot[k]; count > 0 {
+			requests = append(requests, APIRequestCount{Verb: k.verb, Resource: k.resource, Count: count})
+		}
+	}
+	slices.SortFunc(requests, func(a, b APIRequestCount) int {
+		return cmp.Or(cmp.Compare(a.Verb, b.Verb), cmp.Compare(a.Resource, b.Resource))
+	})
+	return requests
+}
+
+// requestVerb maps the HTTP method of a request to its kubernetes API verb
+func requestVerb(req *http.Request) string {
+	switch req.Method {
+	case http.MethodGet:
+		if req.URL.Query().Get("watch") == "true" {
+			return "watch"
+		}
+		if parts := resourceParts(req.URL.Path); parts == nil || len(parts) > 1 {
+			return "get"
+		}
+		return "list"
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		if hasResourceName(req.URL.Path) {
+			return "delete"
+		}
+		return "deletecollection"
+	}
+	return strings.ToLower(req.Method)
+}
+
+// resourceParts returns the resource, name and subresource segments of an API path,
+// nil for non-resource paths like /version or /healthz
+func resourceParts(path string) []string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(parts) >= 3 && parts[0] == "api":
+		parts = parts[2:]
+	case len(parts) >= 4 && parts[0] == "apis":
+		parts = parts[3:]
+	default:
+		return nil
+	}
+	// Namespaced resources, /namespaces/<namespace> alone refers to the namespace itself
+	if len(parts) > 2 && parts[0] == "namespaces" {
+		parts = parts[2:]
+	}
+	return parts
+}
+
+func hasResourceName(path string) bool {
+	return len(resourceParts(path)) > 1
+}
+
+// requestResource returns the resource of an API path, including its subresource, i.e. pods/eviction
+func requestResource(path string) string {
+	parts := resourceParts(path)
+	switch len(parts) {
+	case 0:
+		return path
+	case 1, 2:
+		return parts[0]
+	}
+	return parts[0] + "/" + parts[2]
+}
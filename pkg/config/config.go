@@ -17,10 +17,12 @@ package config
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"time"
 
 	"maps"
@@ -31,7 +33,10 @@ import (
 	"github.com/kube-burner/kube-burner/pkg/util"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -43,11 +48,16 @@ var configSpec = Spec{
 		GC:                false,
 		GCMetrics:         false,
 		GCTimeout:         1 * time.Hour,
+		HookTimeout:       1 * time.Hour,
 		RequestTimeout:    60 * time.Second,
 		Measurements:      []mtypes.Measurement{},
 		WaitWhenFinished:  false,
 		Timeout:           4 * time.Hour,
 		FunctionTemplates: []string{},
+		AlertAbort: AlertAbort{
+			Severity: "critical",
+		},
+		OutputFormat: OutputFormatJSON,
 	},
 }
 
@@ -55,17 +65,38 @@ var configSpec = Spec{
 func (i *MetricsEndpoint) UnmarshalYAML(unmarshal func(any) error) error {
 	type rawIndexer MetricsEndpoint
 	indexer := rawIndexer{
-		IndexerConfig: indexers.IndexerConfig{
-			InsecureSkipVerify: false,
-			MetricsDirectory:   "collected-metrics",
-			TarballName:        "kube-burner-metrics.tgz",
+		IndexerConfig: IndexerConfig{
+			IndexerConfig: indexers.IndexerConfig{
+				InsecureSkipVerify: false,
+				MetricsDirectory:   "collected-metrics",
+				TarballName:        "kube-burner-metrics.tgz",
+			},
+			Kafka: KafkaConfig{
+				BatchSize: 100,
+			},
 		},
 		SkipTLSVerify: true,
 		Step:          30 * time.Second,
+		QueryRetries:  3,
 	}
 	if err := unmarshal(&indexer); err != nil {
 		return err
 	}
+	if indexer.QueryRetries < 0 || indexer.QueryTimeout < 0 {
+		return fmt.Errorf("queryRetries and queryTimeout must be >= 0")
+	}
+	// The indexers list can't be initialized beforehand, empty fields are set to the defaults of the indexer field
+	for pos := range indexer.Indexers {
+		if indexer.Indexers[pos].MetricsDirectory == "" {
+			indexer.Indexers[pos].MetricsDirectory = "collected-metrics"
+		}
+		if indexer.Indexers[pos].TarballName == "" {
+			indexer.Indexers[pos].TarballName = "kube-burner-metrics.tgz"
+		}
+		if indexer.Indexers[pos].Kafka.BatchSize == 0 {
+			indexer.Indexers[pos].Kafka.BatchSize = 100
+		}
+	}
 	*i = MetricsEndpoint(indexer)
 	return nil
 }
@@ -96,6 +127,21 @@ func (w *Watcher) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+// PodAffinity decodes the affinity of the job, nil when not set. Unknown fields are rejected
+func (j Job) PodAffinity() (*corev1.Affinity, error) {
+	if len(j.Affinity) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(j.Affinity)
+	if err != nil {
+		return nil, err
+	}
+	var affinity corev1.Affinity
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return &affinity, dec.Decode(&affinity)
+}
+
 // UnmarshalYAML implements Unmarshaller to customize job defaults
 func (j *Job) UnmarshalYAML(unmarshal func(any) error) error {
 	type rawJob Job
@@ -109,15 +155,28 @@ func (j *Job) UnmarshalYAML(unmarshal func(any) error) error {
 		ErrorOnVerify:          true,
 		JobType:                CreationJob,
 		WaitForDeletion:        true,
+		RetryBackoff:           1 * time.Second,
+		RetryBackoffFactor:     3,
 		PreLoadImages:          true,
 		PreLoadPeriod:          1 * time.Minute,
-		Churn:                  false,
-		ChurnCycles:            100,
-		ChurnPercent:           10,
-		ChurnDuration:          1 * time.Hour,
-		ChurnDelay:             5 * time.Minute,
-		ChurnDeletionStrategy:  "default",
-		MetricsClosing:         AfterJobPause,
+		PreLoadPauseImage:      "registry.k8s.io/pause:3.10",
+		PreLoadImagePullPolicy: corev1.PullAlways,
+		PreLoadResources: PreLoadResources{
+			Requests: map[corev1.ResourceName]string{
+				corev1.ResourceCPU:    "10m",
+				corev1.ResourceMemory: "16Mi",
+			},
+		},
+		Churn:                 false,
+		ChurnCycles:           100,
+		ChurnPercent:          10,
+		ChurnDuration:         1 * time.Hour,
+		ChurnDelay:            5 * time.Minute,
+		ChurnDeletionStrategy: ChurnDeletionDefault,
+		ChurnMode:             ChurnModeDefault,
+		MetricsClosing:        AfterJobPause,
+		ContentType:           ContentTypeJSON,
+		NamespacedBy:          NamespacedByKubeBurner,
 	}
 
 	if err := unmarshal(&raw); err != nil {
@@ -186,26 +245,218 @@ func ParseWithUserdata(uuid string, timeout time.Duration, configFileReader, use
 	if err := jobIsDuped(); err != nil {
 		return configSpec, err
 	}
+	for i := range configSpec.Jobs {
+		if err := applyValuesFiles(&configSpec.Jobs[i]); err != nil {
+			return configSpec, err
+		}
+	}
+	switch configSpec.GlobalConfig.AlertAbort.Severity {
+	case "warning", "error", "critical":
+	default:
+		return configSpec, fmt.Errorf("invalid alertAbort severity: %s", configSpec.GlobalConfig.AlertAbort.Severity)
+	}
+	if _, ok := OutputFormats[configSpec.GlobalConfig.OutputFormat]; !ok {
+		return configSpec, fmt.Errorf("invalid outputFormat: %s", configSpec.GlobalConfig.OutputFormat)
+	}
+	if cordon := configSpec.GlobalConfig.Cordon; cordon.Percent < 0 || cordon.Percent > 100 {
+		return configSpec, fmt.Errorf("cordon percent must be between 0 and 100")
+	}
+	if configSpec.GlobalConfig.CheckpointInterval < 0 {
+		return configSpec, fmt.Errorf("checkpointInterval must be greater than or equal to 0")
+	}
+	if configSpec.GlobalConfig.HookTimeout < 0 {
+		return configSpec, fmt.Errorf("hookTimeout must be greater than or equal to 0")
+	}
 	if err := validateDNS1123(); err != nil {
 		return configSpec, err
 	}
 	for i, job := range configSpec.Jobs {
-		if len(job.Namespace) > 62 {
+		if _, ok := namespacedBy[job.NamespacedBy]; !ok {
+			log.Fatalf("Invalid value for namespacedBy: %s", job.NamespacedBy)
+		}
+		if job.NamespacedBy == NamespacedByExisting {
+			if job.Namespace == "" {
+				log.Fatalf("Job %s: namespace is required by namespacedBy existing", job.Name)
+			}
+			if job.Churn {
+				log.Fatalf("Job %s: churn can't be enabled with namespacedBy existing", job.Name)
+			}
+			// Objects are created in the given namespace
+			configSpec.Jobs[i].NamespacedIterations = false
+			job.NamespacedIterations = false
+		} else if len(job.Namespace) > 62 {
 			log.Warnf("Namespace %s length has > 62 characters, truncating it", job.Namespace)
 			configSpec.Jobs[i].Namespace = job.Namespace[:57]
 		}
 		if !job.NamespacedIterations && job.Churn {
 			log.Fatal("Cannot have Churn enabled without Namespaced Iterations also enabled")
 		}
-		if job.JobIterations < 1 && (job.JobType == CreationJob || job.JobType == ReadJob) {
+		if job.JobIterations < 1 && (job.JobType == CreationJob || job.JobType == ReadJob || job.JobType == ListJob || job.JobType == ScaleJob) {
 			log.Fatalf("Job %s has < 1 iterations", job.Name)
 		}
 		if _, ok := metricsClosing[job.MetricsClosing]; !ok {
 			log.Fatalf("Invalid value for metricsClosing: %s", job.MetricsClosing)
 		}
-		if job.JobType == DeletionJob {
+		if _, ok := contentTypes[job.ContentType]; !ok {
+			log.Fatalf("Invalid value for contentType: %s", job.ContentType)
+		}
+		if _, ok := churnDeletionStrategies[job.ChurnDeletionStrategy]; !ok {
+			log.Fatalf("Invalid value for churnDeletionStrategy: %s", job.ChurnDeletionStrategy)
+		}
+		if job.ChurnDeletionStrategy == ChurnDeletionLabel && len(job.ChurnDeletionLabelSelector) == 0 {
+			log.Fatalf("Job %s: churnDeletionLabelSelector is required by the label churn deletion strategy", job.Name)
+		}
+		if _, ok := churnModes[job.ChurnMode]; !ok {
+			log.Fatalf("Invalid value for churnMode: %s", job.ChurnMode)
+		}
+		if job.ChurnMode == ChurnModeSteadyState && job.ChurnDeletionStrategy == ChurnDeletionLabel {
+			log.Fatalf("Job %s: the label churn deletion strategy can't be used along with the steadyState churn mode", job.Name)
+		}
+		if job.CreationJitter.Min < 0 || job.CreationJitter.Min > job.CreationJitter.Max {
+			log.Fatalf("Job %s: creationJitter min must be between 0 and max", job.Name)
+		}
+		if job.RepeatUntil.Enabled() && job.Churn {
+			log.Fatalf("Job %s: repeatUntil can't be used along with churn", job.Name)
+		}
+		if job.RepeatUntil.Duration < 0 {
+			log.Fatalf("Job %s: repeatUntil duration must be >= 0", job.Name)
+		}
+		if job.RepeatUntil.Delay < 0 {
+			log.Fatalf("Job %s: repeatUntil delay must be >= 0", job.Name)
+		}
+		for _, obj := range job.Objects {
+			if obj.CreateOrder < 0 {
+				log.Fatalf("Job %s: createOrder of object %s must be greater than or equal to 0", job.Name, obj.ObjectTemplate)
+			}
+			if obj.Weight < 0 {
+				log.Fatalf("Job %s: weight of object %s must be >= 0", job.Name, obj.ObjectTemplate)
+			}
+			if obj.Weight > 0 && obj.RunOnce {
+				log.Fatalf("Job %s: weight of object %s can't be used along with runOnce", job.Name, obj.ObjectTemplate)
+			}
+			if obj.GenerateName && job.JobType != CreationJob {
+				log.Fatalf("Job %s: generateName of object %s is only supported in create jobs", job.Name, obj.ObjectTemplate)
+			}
+			if obj.GenerateName && job.ServerSideApply {
+				log.Fatalf("Job %s: generateName of object %s can't be used along with serverSideApply", job.Name, obj.ObjectTemplate)
+			}
+			if obj.QPS < 0 || obj.Burst < 0 {
+				log.Fatalf("Job %s: qps and burst of object %s must be >= 0", job.Name, obj.ObjectTemplate)
+			}
+			if (obj.QPS > 0 || obj.Burst > 0) && job.JobType != CreationJob {
+				log.Fatalf("Job %s: qps and burst of objects are only supported in create jobs", job.Name)
+			}
+			if obj.Burst > 0 && obj.QPS == 0 {
+				log.Fatalf("Job %s: burst of object %s requires qps", job.Name, obj.ObjectTemplate)
+			}
+			if job.JobType == ScaleJob {
+				if (obj.ScaleReplicas == nil) == (obj.ScaleDelta == 0) {
+					log.Fatalf("Job %s: object %s requires either scaleReplicas or scaleDelta", job.Name, obj.Kind)
+				}
+				if obj.ScaleReplicas != nil && *obj.ScaleReplicas < 0 {
+					log.Fatalf("Job %s: scaleReplicas of object %s must be >= 0", job.Name, obj.Kind)
+				}
+			} else if obj.ScaleReplicas != nil || obj.ScaleDelta != 0 {
+				log.Fatalf("Job %s: scaleReplicas and scaleDelta are only supported in scale jobs", job.Name)
+			}
+			if (len(obj.EnabledOn) > 0 || len(obj.SkipOn) > 0) && job.JobType != CreationJob {
+				log.Fatalf("Job %s: enabledOn and skipOn are only supported in create jobs", job.Name)
+			}
+			for _, p := range slices.Concat(obj.EnabledOn, obj.SkipOn) {
+				if _, ok := platforms[p]; !ok {
+					log.Fatalf("Job %s: invalid platform %s in object %s, supported platforms are %s and %s", job.Name, p, obj.ObjectTemplate, PlatformOpenShift, PlatformKubernetes)
+				}
+			}
+		}
+		if job.ServerSideApply && job.JobType != CreationJob {
+			log.Fatalf("Job %s: serverSideApply is only supported in create jobs", job.Name)
+		}
+		if job.ObjectWaitTimeout < 0 {
+			log.Fatalf("Job %s: objectWaitTimeout must be >= 0", job.Name)
+		}
+		if job.NamespaceBatchSize < 0 || job.NamespaceBatchPause < 0 {
+			log.Fatalf("Job %s: namespaceBatchSize and namespaceBatchPause must be >= 0", job.Name)
+		}
+		if job.NamespaceBatchSize > 0 && job.JobType != CreationJob {
+			log.Fatalf("Job %s: namespaceBatchSize is only supported in create jobs", job.Name)
+		}
+		if job.PartialFailureThreshold < 0 || job.PartialFailureThreshold > 100 {
+			log.Fatalf("Job %s: partialFailureThreshold must be between 0 and 100", job.Name)
+		}
+		if job.PartialFailureThreshold > 0 && job.ObjectWaitTimeout == 0 {
+			log.Fatalf("Job %s: partialFailureThreshold requires objectWaitTimeout", job.Name)
+		}
+		if job.WarmupIterations < 0 {
+			log.Fatalf("Job %s: warmupIterations must be >= 0", job.Name)
+		}
+		if job.WarmupIterations > 0 && job.JobType != CreationJob {
+			log.Fatalf("Job %s: warmupIterations is only supported in create jobs", job.Name)
+		}
+		if job.PodFaults.Enabled() {
+			if job.JobType != CreationJob {
+				log.Fatalf("Job %s: podFaults is only supported in create jobs", job.Name)
+			}
+			if job.PodFaults.Percent < 1 || job.PodFaults.Percent > 100 {
+				log.Fatalf("Job %s: podFaults percent must be between 1 and 100", job.Name)
+			}
+			if job.PodFaults.RecoveryTimeout < 0 {
+				log.Fatalf("Job %s: podFaults recoveryTimeout must be >= 0", job.Name)
+			}
+		}
+		if len(job.NodeWeights) > 0 && job.JobType != CreationJob {
+			log.Fatalf("Job %s: nodeWeights is only supported in create jobs", job.Name)
+		}
+		if job.PriorityClassName != "" {
+			if job.JobType != CreationJob {
+				log.Fatalf("Job %s: priorityClassName is only supported in create jobs", job.Name)
+			}
+			if errs := validation.IsDNS1123Subdomain(job.PriorityClassName); len(errs) > 0 {
+				log.Fatalf("Job %s: invalid priorityClassName %s: %v", job.Name, job.PriorityClassName, errs)
+			}
+		}
+		if len(job.Affinity) > 0 {
+			if job.JobType != CreationJob {
+				log.Fatalf("Job %s: affinity is only supported in create jobs", job.Name)
+			}
+			if _, err := job.PodAffinity(); err != nil {
+				log.Fatalf("Job %s: invalid affinity: %v", job.Name, err)
+			}
+		}
+		for _, nodeWeight := range job.NodeWeights {
+			if len(nodeWeight.Labels) == 0 {
+				log.Fatalf("Job %s: labels are required by every node pool of nodeWeights", job.Name)
+			}
+			if nodeWeight.Weight < 1 {
+				log.Fatalf("Job %s: weight of node pool %v must be >= 1", job.Name, nodeWeight.Labels)
+			}
+		}
+		if job.GracePeriodSeconds != nil && *job.GracePeriodSeconds < 0 {
+			log.Fatalf("Job %s: gracePeriodSeconds must be >= 0", job.Name)
+		}
+		if job.Evict && job.JobType != DeletionJob {
+			log.Fatalf("Job %s: evict is only supported in delete jobs", job.Name)
+		}
+		if job.Force && job.GracePeriodSeconds != nil && *job.GracePeriodSeconds != 0 {
+			log.Fatalf("Job %s: gracePeriodSeconds must be 0 or unset when force is enabled", job.Name)
+		}
+		if job.JobType == DeletionJob || job.JobType == ListJob || job.JobType == ScaleJob {
 			configSpec.Jobs[i].PreLoadImages = false
 		}
+		switch job.PreLoadImagePullPolicy {
+		case corev1.PullAlways, corev1.PullIfNotPresent, corev1.PullNever:
+		default:
+			log.Fatalf("Invalid value for preLoadImagePullPolicy: %s", job.PreLoadImagePullPolicy)
+		}
+		for name, value := range job.PreLoadResources.Requests {
+			if _, err := resource.ParseQuantity(value); err != nil {
+				log.Fatalf("Invalid preLoadResources request %s: %v", name, err)
+			}
+		}
+		for name, value := range job.PreLoadResources.Limits {
+			if _, err := resource.ParseQuantity(value); err != nil {
+				log.Fatalf("Invalid preLoadResources limit %s: %v", name, err)
+			}
+		}
 	}
 	configSpec.GlobalConfig.Timeout = timeout
 	configSpec.GlobalConfig.UUID = uuid
@@ -237,7 +488,10 @@ func NewKubeClientProvider(config, context string) *KubeClientProvider {
 			log.Fatalf("error preparing kubernetes client: %s", err)
 		}
 	}
-	return &KubeClientProvider{restConfig: restConfig}
+	// All the clients built from the provider share the request counter
+	apiRequests := &apiRequestCounter{counts: make(APIRequestSnapshot)}
+	restConfig.Wrap(apiRequests.wrap)
+	return &KubeClientProvider{restConfig: restConfig, apiRequests: apiRequests}
 }
 
 func (p *KubeClientProvider) DefaultClientSet() (kubernetes.Interface, *rest.Config) {
@@ -252,6 +506,18 @@ func (p *KubeClientProvider) ClientSet(QPS float32, burst int) (kubernetes.Inter
 	return kubernetes.NewForConfigOrDie(&restConfig), &restConfig
 }
 
+// JobClientSet returns a clientSet configured with the QPS, burst and content type of the given job
+func (p *KubeClientProvider) JobClientSet(job Job) (kubernetes.Interface, *rest.Config) {
+	restConfig := *p.restConfig
+	restConfig.QPS, restConfig.Burst = job.QPS, job.Burst
+	restConfig.Timeout = configSpec.GlobalConfig.RequestTimeout
+	if job.ContentType == ContentTypeProtobuf {
+		restConfig.ContentType = runtime.ContentTypeProtobuf
+		restConfig.AcceptContentTypes = runtime.ContentTypeProtobuf + "," + runtime.ContentTypeJSON
+	}
+	return kubernetes.NewForConfigOrDie(&restConfig), &restConfig
+}
+
 // FetchConfigMap Fetchs the specified configmap and looks for config.yml, metrics.yml and alerts.yml files
 func FetchConfigMap(configMap, namespace string) (string, string, error) {
 	log.Infof("Fetching configmap %s", configMap)
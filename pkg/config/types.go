@@ -19,6 +19,7 @@ import (
 
 	"github.com/cloud-bulldozer/go-commons/v2/indexers"
 	mtypes "github.com/kube-burner/kube-burner/pkg/measurements/types"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/rest"
 )
 
@@ -34,8 +35,12 @@ const (
 	PatchJob JobType = "patch"
 	// ReadJob used to read objects
 	ReadJob JobType = "read"
+	// ListJob used to benchmark paginated LIST requests
+	ListJob JobType = "list"
 	// KubeVirtJob used to send command to the KubeVirt service
 	KubeVirtJob JobType = "kubevirt"
+	// ScaleJob used to scale existing deployments and statefulsets
+	ScaleJob JobType = "scale"
 )
 
 type KubeVirtOpType string
@@ -63,16 +68,110 @@ type Spec struct {
 
 // metricEndpoint describes prometheus endpoint to scrape
 type MetricsEndpoint struct {
-	indexers.IndexerConfig `yaml:"indexer"`
-	Metrics                []string      `yaml:"metrics"`
-	Alerts                 []string      `yaml:"alerts"`
-	Endpoint               string        `yaml:"endpoint"`
-	Step                   time.Duration `yaml:"step"`
-	SkipTLSVerify          bool          `yaml:"skipTLSVerify"`
-	Token                  string        `yaml:"token"`
-	Username               string        `yaml:"username"`
-	Password               string        `yaml:"password"`
-	Alias                  string        `yaml:"alias"`
+	IndexerConfig `yaml:"indexer"`
+	// Indexers list of additional indexers, documents are sent to all of them
+	Indexers      []IndexerConfig `yaml:"indexers"`
+	Metrics       []string        `yaml:"metrics"`
+	Alerts        []string        `yaml:"alerts"`
+	Endpoint      string          `yaml:"endpoint"`
+	Step          time.Duration   `yaml:"step"`
+	SkipTLSVerify bool            `yaml:"skipTLSVerify"`
+	Token         string          `yaml:"token"`
+	Username      string          `yaml:"username"`
+	Password      string          `yaml:"password"`
+	Alias         string          `yaml:"alias"`
+	// Headers sent along with every query request, i.e. X-Scope-OrgID
+	Headers map[string]string `yaml:"headers"`
+	// QueryRetries number of times a failed metrics query is retried
+	QueryRetries int `yaml:"queryRetries"`
+	// QueryTimeout timeout of each metrics query attempt, 0 disables it
+	QueryTimeout time.Duration `yaml:"queryTimeout"`
+}
+
+const (
+	// OTLPIndexer indexer that exports documents to an OpenTelemetry collector
+	OTLPIndexer indexers.IndexerType = "otlp"
+	// KafkaIndexer indexer that publishes documents to a Kafka topic
+	KafkaIndexer indexers.IndexerType = "kafka"
+	// RemoteWriteIndexer indexer that pushes documents to a Prometheus remote-write endpoint
+	RemoteWriteIndexer indexers.IndexerType = "remote-write"
+)
+
+// IndexerConfig extends the indexer configuration with the indexers implemented by kube-burner
+type IndexerConfig struct {
+	indexers.IndexerConfig `yaml:",inline"`
+	// OTLP OpenTelemetry indexer configuration
+	OTLP OTLPConfig `yaml:"otlp"`
+	// Gzip compresses the files written by the local indexer
+	Gzip bool `yaml:"gzip"`
+	// PerMeasurement makes the local indexer write a file per measurement type holding the documents of all the jobs
+	PerMeasurement bool `yaml:"perMeasurement"`
+	// Kafka indexer configuration
+	Kafka KafkaConfig `yaml:"kafka"`
+	// RemoteWrite Prometheus remote-write indexer configuration
+	RemoteWrite RemoteWriteConfig `yaml:"remoteWrite"`
+	// OpenSearch basic authentication, only supported by the opensearch indexer
+	OpenSearch OpenSearchConfig `yaml:"opensearch"`
+}
+
+// OpenSearchConfig holds the basic authentication credentials of the OpenSearch indexer
+type OpenSearchConfig struct {
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+// Enabled returns true when the OpenSearch credentials are configured
+func (o OpenSearchConfig) Enabled() bool {
+	return o.Username != "" || o.Password != ""
+}
+
+// RemoteWriteConfig holds the Prometheus remote-write indexer configuration
+type RemoteWriteConfig struct {
+	// URL of the remote-write endpoint, i.e. http://mimir:9009/api/v1/push
+	URL string `yaml:"url"`
+	// Headers sent along with every request, i.e. X-Scope-OrgID
+	Headers map[string]string `yaml:"headers"`
+	// Username and password for basic authentication
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	// Token bearer token
+	Token string `yaml:"token"`
+}
+
+// OTLPConfig holds the OpenTelemetry indexer configuration, unset fields fall back to the standard OTEL_* environment variables
+type OTLPConfig struct {
+	// Endpoint OTLP endpoint of the collector
+	Endpoint string `yaml:"endpoint"`
+	// Protocol OTLP transport, grpc or http/json
+	Protocol string `yaml:"protocol"`
+	// Headers sent along with every export request
+	Headers map[string]string `yaml:"headers"`
+	// ResourceAttributes attributes describing the run, such as the cluster name
+	ResourceAttributes map[string]string `yaml:"resourceAttributes"`
+}
+
+// KafkaConfig holds the Kafka indexer configuration
+type KafkaConfig struct {
+	// Brokers list of bootstrap brokers, in host:port format
+	Brokers []string `yaml:"brokers"`
+	// Topic documents are published to
+	Topic string `yaml:"topic"`
+	// BatchSize maximum number of documents sent per produce request
+	BatchSize int `yaml:"batchSize"`
+	// Compression codec of the record batches, none or gzip
+	Compression string `yaml:"compression"`
+	// TLS enables TLS in the broker connections
+	TLS bool `yaml:"tls"`
+	// SASL authentication configuration
+	SASL KafkaSASL `yaml:"sasl"`
+}
+
+// KafkaSASL holds the SASL authentication configuration of the Kafka indexer
+type KafkaSASL struct {
+	// Mechanism SASL mechanism, only PLAIN is supported
+	Mechanism string `yaml:"mechanism"`
+	Username  string `yaml:"username"`
+	Password  string `yaml:"password"`
 }
 
 // GlobalConfig holds the global configuration
@@ -100,6 +199,43 @@ type GlobalConfig struct {
 	Timeout time.Duration `yaml:"timeout"`
 	// Function templates to render at runtime
 	FunctionTemplates []string `yaml:"functionTemplates"`
+	// AlertAbort evaluates the alert profiles during the benchmark, aborting it when an alert fires
+	AlertAbort AlertAbort `yaml:"alertAbort"`
+	// OutputFormat format of the measurement summary, csv writes a CSV file with the latency quantiles besides the indexed documents
+	OutputFormat OutputFormat `yaml:"outputFormat"`
+	// Cordon nodes cordoned during the benchmark
+	Cordon Cordon `yaml:"cordon"`
+	// CheckpointInterval interval between writes of the run checkpoint, 0 disables checkpointing
+	CheckpointInterval time.Duration `yaml:"checkpointInterval"`
+	// Resume resumes the run from its checkpoint
+	Resume bool `yaml:"-"`
+	// PreRunHook command run before the benchmark, the run is aborted when it fails
+	PreRunHook string `yaml:"preRunHook"`
+	// PostRunHook command run after the benchmark, even when it fails
+	PostRunHook string `yaml:"postRunHook"`
+	// HookTimeout timeout of each run hook
+	HookTimeout time.Duration `yaml:"hookTimeout"`
+}
+
+// Cordon defines the nodes cordoned when the benchmark starts and uncordoned when it finishes
+type Cordon struct {
+	// LabelSelector only the nodes matching these labels are cordoned
+	LabelSelector map[string]string `yaml:"labelSelector"`
+	// Percent percentage of the matching nodes cordoned, all of them when not set
+	Percent int `yaml:"percent"`
+}
+
+// Enabled returns true when the nodes cordon is configured
+func (c Cordon) Enabled() bool {
+	return len(c.LabelSelector) > 0 || c.Percent > 0
+}
+
+// AlertAbort defines how the alert profiles are evaluated during the benchmark
+type AlertAbort struct {
+	// Interval between evaluations, 0 disables the evaluation during the benchmark
+	Interval time.Duration `yaml:"interval"`
+	// Severity minimum severity of the alerts aborting the benchmark
+	Severity string `yaml:"severity"`
 }
 
 // Object defines an object that kube-burner will create
@@ -119,6 +255,8 @@ type Object struct {
 	APIVersion string `yaml:"apiVersion" json:"apiVersion,omitempty"`
 	// LabelSelector objects with this labels will be removed
 	LabelSelector map[string]string `yaml:"labelSelector" json:"labelSelector,omitempty"`
+	// FieldSelector objects matching these fields will be considered, combined with LabelSelector when both are set
+	FieldSelector map[string]string `yaml:"fieldSelector" json:"fieldSelector,omitempty"`
 	// Wait for resource to be ready, it doesn't apply to all resources
 	Wait bool `yaml:"wait" json:"wait"`
 	// WaitOptions define custom behaviors when waiting for objects creation
@@ -127,6 +265,30 @@ type Object struct {
 	RunOnce bool `yaml:"runOnce" json:"runOnce,omitempty"`
 	// KubeVirt Operation
 	KubeVirtOp KubeVirtOpType `yaml:"kubeVirtOp" json:"kubeVirtOp,omitempty"`
+	// PreLoadImagePaths list of JSONPath expressions used to extract additional images to pre-load from the object
+	PreLoadImagePaths []string `yaml:"preLoadImagePaths" json:"preLoadImagePaths,omitempty"`
+	// Weight relative weight of the object in the mix of weighted objects of create jobs, one of the weighted objects
+	// is sampled per iteration. Objects without weight are created in every iteration
+	Weight int `yaml:"weight" json:"weight,omitempty"`
+	// CreateOrder wave in which the object is created by create jobs, waves are created in ascending order
+	// and objects without order are created in a final wave
+	CreateOrder int `yaml:"createOrder" json:"createOrder,omitempty"`
+	// PageSize maximum number of objects returned per page by the LIST requests of list jobs, 0 disables pagination
+	PageSize int64 `yaml:"pageSize" json:"pageSize,omitempty"`
+	// EnabledOn platforms the object is created on, the object is created on every platform when empty
+	EnabledOn []Platform `yaml:"enabledOn" json:"enabledOn,omitempty"`
+	// SkipOn platforms the object isn't created on
+	SkipOn []Platform `yaml:"skipOn" json:"skipOn,omitempty"`
+	// GenerateName creates the object with its rendered name as metadata.generateName prefix, so the API server assigns a unique name
+	GenerateName bool `yaml:"generateName" json:"generateName,omitempty"`
+	// QPS creation rate limit of the object replicas, applied on top of the job QPS
+	QPS float32 `yaml:"qps" json:"qps,omitempty"`
+	// Burst burst of the object creation rate limit, defaults to the object QPS
+	Burst int `yaml:"burst" json:"burst,omitempty"`
+	// ScaleReplicas replica count set by scale jobs on the objects matching the selector
+	ScaleReplicas *int32 `yaml:"scaleReplicas" json:"scaleReplicas,omitempty"`
+	// ScaleDelta replicas added, or removed when negative, by scale jobs on every iteration
+	ScaleDelta int32 `yaml:"scaleDelta" json:"scaleDelta,omitempty"`
 }
 
 // Job defines a kube-burner job
@@ -135,6 +297,10 @@ type Job struct {
 	JobIterations int `yaml:"jobIterations" json:"jobIterations,omitempty"`
 	// IterationDelay how much time to wait between each job iteration
 	JobIterationDelay time.Duration `yaml:"jobIterationDelay" json:"jobIterationDelay,omitempty"`
+	// NamespaceBatchSize number of namespaces created by create jobs before pausing for NamespaceBatchPause, 0 disables the batching
+	NamespaceBatchSize int `yaml:"namespaceBatchSize" json:"namespaceBatchSize,omitempty"`
+	// NamespaceBatchPause pause between namespace batches
+	NamespaceBatchPause time.Duration `yaml:"namespaceBatchPause" json:"namespaceBatchPause,omitempty"`
 	// JobPause how much time to pause after finishing the job
 	JobPause time.Duration `yaml:"jobPause" json:"jobPause,omitempty"`
 	// BeforeCleanup allows to run a bash script before the workload is deleted.
@@ -143,6 +309,8 @@ type Job struct {
 	Name string `yaml:"name" json:"name,omitempty"`
 	// Objects list of objects
 	Objects []Object `yaml:"objects" json:"-"`
+	// ValuesFiles YAML files merged into the input variables of the objects, later files override earlier ones
+	ValuesFiles []string `yaml:"valuesFiles" json:"valuesFiles,omitempty"`
 	// Watchers list of watchers
 	Watchers []Watcher `yaml:"watchers" json:"-"`
 	// JobType type of job
@@ -151,12 +319,52 @@ type Job struct {
 	QPS float32 `yaml:"qps" json:"qps,omitempty"`
 	// Maximum burst for throttle
 	Burst int `yaml:"burst" json:"burst,omitempty"`
+	// QPSRamp gradually increases the QPS of the job during its execution
+	QPSRamp QPSRamp `yaml:"qpsRamp" json:"qpsRamp,omitempty"`
+	// CreationJitter randomized delay between object creations
+	CreationJitter CreationJitter `yaml:"creationJitter" json:"creationJitter,omitempty"`
+	// RepeatUntil runs the job in a loop until the stop condition is met
+	RepeatUntil RepeatUntil `yaml:"repeatUntil" json:"repeatUntil,omitempty"`
+	// PodFaults periodically deletes a percentage of the running pods of create jobs, measuring their recovery
+	PodFaults PodFaults `yaml:"podFaults" json:"podFaults,omitempty"`
 	// Namespace namespace base name to use
 	Namespace string `yaml:"namespace" json:"namespace,omitempty"`
+	// ContentType serialization of the requests sent by the job, json or protobuf
+	ContentType ContentType `yaml:"contentType" json:"contentType,omitempty"`
 	// MaxWaitTimeout maximum wait period
 	MaxWaitTimeout time.Duration `yaml:"maxWaitTimeout" json:"maxWaitTimeout,omitempty"`
+	// ObjectWaitTimeout maximum wait period of each object, objects exceeding it are accounted as timed out instead of aborting the job
+	ObjectWaitTimeout time.Duration `yaml:"objectWaitTimeout" json:"objectWaitTimeout,omitempty"`
+	// PartialFailureThreshold maximum percentage of objects exceeding objectWaitTimeout for the job to be flagged as partially failed instead of failed
+	PartialFailureThreshold float64 `yaml:"partialFailureThreshold" json:"partialFailureThreshold,omitempty"`
+	// WarmupIterations iterations created and deleted before the measured phase of create jobs, excluded from measurements, metrics and the job summary
+	WarmupIterations int `yaml:"warmupIterations" json:"warmupIterations,omitempty"`
+	// NodeWeights node pools the pods created by create jobs are spread across according to their weights
+	NodeWeights []NodeWeight `yaml:"nodeWeights" json:"nodeWeights,omitempty"`
+	// PriorityClassName priority class set in the pod specs of the objects created by create jobs, overriding the one of the templates
+	PriorityClassName string `yaml:"priorityClassName" json:"priorityClassName,omitempty"`
+	// Affinity pod affinity rules merged into the pod specs of the objects created by create jobs, in the format of the pod spec affinity
+	Affinity map[string]any `yaml:"affinity" json:"affinity,omitempty"`
+	// MaxRetries maximum number of retries of each object creation, 0 means retrying until maxWaitTimeout is reached
+	MaxRetries int `yaml:"maxRetries" json:"maxRetries,omitempty"`
+	// RetryBackoff initial wait period between object creation retries
+	RetryBackoff time.Duration `yaml:"retryBackoff" json:"retryBackoff,omitempty"`
+	// RetryBackoffFactor factor the wait period between retries is multiplied by on each retry
+	RetryBackoffFactor float64 `yaml:"retryBackoffFactor" json:"retryBackoffFactor,omitempty"`
+	// ServerSideApply creates the objects of create jobs using server-side apply
+	ServerSideApply bool `yaml:"serverSideApply" json:"serverSideApply,omitempty"`
+	// FieldManager field manager name of the server-side apply requests, kube-burner when not set
+	FieldManager string `yaml:"fieldManager" json:"fieldManager,omitempty"`
+	// ForceConflicts forces the ownership of the conflicting fields of the server-side apply requests
+	ForceConflicts bool `yaml:"forceConflicts" json:"forceConflicts,omitempty"`
 	// WaitForDeletion wait for objects to be definitively deleted
 	WaitForDeletion bool `yaml:"waitForDeletion" json:"waitForDeletion,omitempty"`
+	// GracePeriodSeconds grace period of the delete requests sent by delete jobs, the default grace period of the object is used when not set
+	GracePeriodSeconds *int64 `yaml:"gracePeriodSeconds" json:"gracePeriodSeconds,omitempty"`
+	// Force force-deletes the objects of delete jobs, with a grace period of 0 and background propagation
+	Force bool `yaml:"force" json:"force,omitempty"`
+	// Evict removes the pods of delete jobs through the eviction API, honoring their PodDisruptionBudgets
+	Evict bool `yaml:"evict" json:"evict,omitempty"`
 	// PodWait wait for all pods to be running before moving forward to the next iteration
 	PodWait bool `yaml:"podWait" json:"podWait,omitempty"`
 	// WaitWhenFinished Wait for pods to be running when all job iterations are completed
@@ -165,18 +373,36 @@ type Job struct {
 	Cleanup bool `yaml:"cleanup" json:"cleanup,omitempty"`
 	// NamespacedIterations create a namespace per job iteration
 	NamespacedIterations bool `yaml:"namespacedIterations" json:"namespacedIterations,omitempty"`
+	// NamespacedBy who manages the namespace of the job objects, kube-burner or an existing namespace whose lifecycle isn't managed by kube-burner
+	NamespacedBy NamespacedBy `yaml:"namespacedBy" json:"namespacedBy,omitempty"`
 	// IterationsPerNamespace is the modulus to apply to job iterations to calculate . Default 1
 	IterationsPerNamespace int `yaml:"iterationsPerNamespace" json:"iterationsPerNamespace,omitempty"`
 	// VerifyObjects verify object count after running the job
 	VerifyObjects bool `yaml:"verifyObjects" json:"verifyObjects,omitempty"`
 	// ErrorOnVerify exit when verification fails
 	ErrorOnVerify bool `yaml:"errorOnVerify" json:"errorOnVerify,omitempty"`
+	// VerifyReadiness checks that all the created objects satisfy their ready condition after running the job
+	VerifyReadiness bool `yaml:"verifyReadiness" json:"verifyReadiness,omitempty"`
 	// PreLoadImages enables pulling all images before running the job
 	PreLoadImages bool `yaml:"preLoadImages" json:"preLoadImages,omitempty"`
-	// PreLoadPeriod determines the duration of the preload stage
+	// PreLoadPeriod determines the maximum duration of the preload stage
 	PreLoadPeriod time.Duration `yaml:"preLoadPeriod" json:"preLoadPeriod,omitempty"`
 	// PreLoadNodeLabels add node selector labels to resources in preload stage
 	PreLoadNodeLabels map[string]string `yaml:"preLoadNodeLabels" json:"-"`
+	// PreLoadImagePullSecrets list of existing pull secrets, in <namespace>/<name> format, to use in the preload stage
+	PreLoadImagePullSecrets []string `yaml:"preLoadImagePullSecrets" json:"-"`
+	// PreLoadTolerations add tolerations to the resources in preload stage
+	PreLoadTolerations []corev1.Toleration `yaml:"preLoadTolerations" json:"-"`
+	// PreLoadTolerateAll makes the preload stage tolerate every taint
+	PreLoadTolerateAll bool `yaml:"preLoadTolerateAll" json:"preLoadTolerateAll,omitempty"`
+	// PreLoadImagesPerDaemonSet limits the number of images pulled by each preload DaemonSet, 0 means unlimited
+	PreLoadImagesPerDaemonSet int `yaml:"preLoadImagesPerDaemonSet" json:"preLoadImagesPerDaemonSet,omitempty"`
+	// PreLoadPauseImage image used by the keep-alive container of the preload DaemonSet
+	PreLoadPauseImage string `yaml:"preLoadPauseImage" json:"-"`
+	// PreLoadImagePullPolicy pull policy of the preload init containers
+	PreLoadImagePullPolicy corev1.PullPolicy `yaml:"preLoadImagePullPolicy" json:"preLoadImagePullPolicy,omitempty"`
+	// PreLoadResources resource requests and limits of the preload containers
+	PreLoadResources PreLoadResources `yaml:"preLoadResources" json:"-"`
 	// NamespaceLabels add custom labels to namespaces created by kube-burner
 	NamespaceLabels map[string]string `yaml:"namespaceLabels" json:"-"`
 	// NamespaceAnnotations add custom annotations to namespaces created by kube-burner
@@ -193,6 +419,10 @@ type Job struct {
 	ChurnDelay time.Duration `yaml:"churnDelay" json:"churnDelay,omitempty"`
 	// Churn deletion strategy
 	ChurnDeletionStrategy string `yaml:"churnDeletionStrategy" json:"churnDeletionStrategy,omitempty"`
+	// ChurnDeletionLabelSelector objects deleted by the label churn deletion strategy
+	ChurnDeletionLabelSelector map[string]string `yaml:"churnDeletionLabelSelector" json:"churnDeletionLabelSelector,omitempty"`
+	// ChurnMode how objects are churned, in separate deletion and creation phases or keeping the live object count constant
+	ChurnMode ChurnMode `yaml:"churnMode" json:"churnMode,omitempty"`
 	// Skip this job from indexing
 	SkipIndexing               bool `yaml:"skipIndexing" json:"skipIndexing,omitempty"`
 	DefaultMissingKeysWithZero bool `yaml:"defaultMissingKeysWithZero" json:"defaultMissingKeysWithZero,omitempty"`
@@ -208,6 +438,12 @@ type Job struct {
 	MetricsClosing MetricsClosing `yaml:"metricsClosing" json:"metricsClosing,omitempty"`
 }
 
+// PreLoadResources resource requests and limits expressed as quantities, e.g. cpu: 10m
+type PreLoadResources struct {
+	Requests map[corev1.ResourceName]string `yaml:"requests" json:"requests,omitempty"`
+	Limits   map[corev1.ResourceName]string `yaml:"limits" json:"limits,omitempty"`
+}
+
 type WaitOptions struct {
 	// Kind object kind to consider for wait
 	Kind string `yaml:"kind" json:"kind,omitempty"`
@@ -215,6 +451,14 @@ type WaitOptions struct {
 	LabelSelector map[string]string `yaml:"labelSelector" json:"labelSelector,omitempty"`
 	// CustomStatusPaths defines the list of jq path specific status fields to check (e.g., [{"key":".[]conditions.type","value":"Available"}]).
 	CustomStatusPaths []StatusPath `yaml:"customStatusPaths" json:"customStatusPaths,omitempty"`
+	// WaitCondition status condition to wait for, it applies to any object kind exposing status.conditions
+	WaitCondition *WaitCondition `yaml:"waitCondition" json:"waitCondition,omitempty"`
+}
+
+// WaitCondition defines a status condition type and its expected status
+type WaitCondition struct {
+	Type   string `yaml:"type" json:"type"`
+	Status string `yaml:"status" json:"status,omitempty"`
 }
 
 type Watcher struct {
@@ -226,6 +470,62 @@ type Watcher struct {
 	Replicas int `yaml:"replicas" json:"replicas,omitempty"`
 }
 
+// NodeWeight node pool identified by its node labels, and the relative weight of the pods placed on it
+type NodeWeight struct {
+	// Labels node labels of the pool
+	Labels map[string]string `yaml:"labels" json:"labels"`
+	// Weight relative weight of the pool
+	Weight int `yaml:"weight" json:"weight"`
+}
+
+// PodFaults defines the pod deletions injected once the objects of a create job are created, until the job finishes
+type PodFaults struct {
+	// Interval period between pod deletions, 0 disables the fault injection
+	Interval time.Duration `yaml:"interval" json:"interval,omitempty"`
+	// Percent percentage of the running pods deleted on each interval
+	Percent int `yaml:"percent" json:"percent,omitempty"`
+	// LabelSelector only the pods matching these labels are deleted
+	LabelSelector map[string]string `yaml:"labelSelector" json:"labelSelector,omitempty"`
+	// RecoveryTimeout maximum period waited for the replacement pods to be ready once the job finishes
+	RecoveryTimeout time.Duration `yaml:"recoveryTimeout" json:"recoveryTimeout,omitempty"`
+}
+
+// Enabled returns true when the pod deletions are configured
+func (p PodFaults) Enabled() bool {
+	return p.Interval > 0
+}
+
+// RepeatUntil defines the stop condition of a job run in a loop, the loop stops as soon as any of the conditions is met
+type RepeatUntil struct {
+	// Duration stops the loop once the job has been running for this time
+	Duration time.Duration `yaml:"duration" json:"duration,omitempty"`
+	// Alert PromQL expression, the loop stops once it returns any sample with a value other than 0
+	Alert string `yaml:"alert" json:"alert,omitempty"`
+	// Delay time to wait between repetitions, it's accounted in the duration
+	Delay time.Duration `yaml:"delay" json:"delay,omitempty"`
+}
+
+// Enabled returns true when any of the stop conditions is set
+func (r RepeatUntil) Enabled() bool {
+	return r.Duration > 0 || r.Alert != ""
+}
+
+// CreationJitter defines a randomized delay between object creations, delays are uniformly distributed between Min and Max
+type CreationJitter struct {
+	Min time.Duration `yaml:"min" json:"min,omitempty"`
+	Max time.Duration `yaml:"max" json:"max,omitempty"`
+	// Seed of the random number generator, a random seed is used when not set
+	Seed int64 `yaml:"seed" json:"seed,omitempty"`
+}
+
+// QPSRamp defines a QPS ramp-up schedule, QPS starts at StartQPS and is increased by Step every Interval until EndQPS is reached
+type QPSRamp struct {
+	StartQPS float32       `yaml:"startQPS" json:"startQPS,omitempty"`
+	EndQPS   float32       `yaml:"endQPS" json:"endQPS,omitempty"`
+	Step     float32       `yaml:"step" json:"step,omitempty"`
+	Interval time.Duration `yaml:"interval" json:"interval,omitempty"`
+}
+
 // StatusPath defines the structure for each key-value pair in CustomStatusPath.
 type StatusPath struct {
 	Key   string `yaml:"key" json:"key"`
@@ -233,7 +533,8 @@ type StatusPath struct {
 }
 
 type KubeClientProvider struct {
-	restConfig *rest.Config
+	restConfig  *rest.Config
+	apiRequests *apiRequestCounter
 }
 
 // Execution mode for Patch jobs
@@ -247,6 +548,8 @@ const (
 const (
 	KubeBurnerLabelJobIteration = "kube-burner.io/job-iteration"
 	KubeBurnerLabelReplica      = "kube-burner.io/replica"
+	// KubeBurnerAnnotationEvictionRequested time the first eviction request of a pod was sent by a delete job
+	KubeBurnerAnnotationEvictionRequested = "kube-burner.io/eviction-requested"
 )
 
 // MetricsCLosing strategy
@@ -263,3 +566,88 @@ var metricsClosing = map[MetricsClosing]struct{}{
 	AfterMeasurements: {},
 	AfterJob:          {},
 }
+
+// Churn deletion strategies
+const (
+	ChurnDeletionDefault = "default"
+	ChurnDeletionGVR     = "gvr"
+	ChurnDeletionFIFO    = "fifo"
+	ChurnDeletionRandom  = "random"
+	ChurnDeletionLabel   = "label"
+)
+
+var churnDeletionStrategies = map[string]struct{}{
+	ChurnDeletionDefault: {},
+	ChurnDeletionGVR:     {},
+	ChurnDeletionFIFO:    {},
+	ChurnDeletionRandom:  {},
+	ChurnDeletionLabel:   {},
+}
+
+// ChurnMode how the objects of a create job are churned
+type ChurnMode string
+
+const (
+	// ChurnModeDefault deletes the churned iterations and re-creates them afterwards
+	ChurnModeDefault ChurnMode = "default"
+	// ChurnModeSteadyState re-creates each churned iteration right after deleting it
+	ChurnModeSteadyState ChurnMode = "steadyState"
+)
+
+var churnModes = map[ChurnMode]struct{}{
+	ChurnModeDefault:     {},
+	ChurnModeSteadyState: {},
+}
+
+// NamespacedBy manager of the namespaces of a create job
+type NamespacedBy string
+
+const (
+	NamespacedByKubeBurner NamespacedBy = "kube-burner"
+	NamespacedByExisting   NamespacedBy = "existing"
+)
+
+var namespacedBy = map[NamespacedBy]struct{}{
+	NamespacedByKubeBurner: {},
+	NamespacedByExisting:   {},
+}
+
+// Platform cluster platform detected at the beginning of each job
+type Platform string
+
+const (
+	PlatformOpenShift  Platform = "OpenShift"
+	PlatformKubernetes Platform = "Kubernetes"
+)
+
+var platforms = map[Platform]struct{}{
+	PlatformOpenShift:  {},
+	PlatformKubernetes: {},
+}
+
+// OutputFormat format of the measurement summary
+type OutputFormat string
+
+const (
+	OutputFormatJSON OutputFormat = "json"
+	OutputFormatCSV  OutputFormat = "csv"
+)
+
+// OutputFormats supported output formats
+var OutputFormats = map[OutputFormat]struct{}{
+	OutputFormatJSON: {},
+	OutputFormatCSV:  {},
+}
+
+// ContentType serialization used by the requests of a job
+type ContentType string
+
+const (
+	ContentTypeJSON     ContentType = "json"
+	ContentTypeProtobuf ContentType = "protobuf"
+)
+
+var contentTypes = map[ContentType]struct{}{
+	ContentTypeJSON:     {},
+	ContentTypeProtobuf: {},
+}
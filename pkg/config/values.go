@@ -0,0 +1,117 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	"gopkg.in/yaml.v3"
+)
+
+// applyValuesFiles merges the values files of the job, later files overriding earlier ones, into the input variables
+// of each object. The input variables of the object take precedence over the values files
+func applyValuesFiles(job *Job) error {
+	if len(job.ValuesFiles) == 0 {
+		return nil
+	}
+	values := make(map[string]any)
+	for _, valuesFile := range job.ValuesFiles {
+		content, err := readValuesFile(valuesFile)
+		if err != nil {
+			return fmt.Errorf("job %s: error reading values file %s: %v", job.Name, valuesFile, err)
+		}
+		fileValues := make(map[string]any)
+		if err := yaml.Unmarshal(content, &fileValues); err != nil {
+			return fmt.Errorf("job %s: error parsing values file %s: %v", job.Name, valuesFile, err)
+		}
+		if err := mergeValues(values, fileValues, ""); err != nil {
+			return fmt.Errorf("job %s: error merging values file %s: %v", job.Name, valuesFile, err)
+		}
+	}
+	for i, obj := range job.Objects {
+		inputVars := copyValues(values)
+		if err := mergeValues(inputVars, obj.InputVars, ""); err != nil {
+			return fmt.Errorf("job %s: error merging inputVars of object %s into the values files: %v", job.Name, obj.ObjectTemplate, err)
+		}
+		job.Objects[i].InputVars = inputVars
+	}
+	return nil
+}
+
+// readValuesFile returns the content of the given values file, closing its reader once read
+func readValuesFile(valuesFile string) ([]byte, error) {
+	f, err := fileutils.GetWorkloadReader(valuesFile, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c, ok := f.(io.Closer); ok {
+		defer c.Close()
+	}
+	return io.ReadAll(f)
+}
+
+// mergeValues merges src into dst like Helm does: maps are merged recursively, while other values in src replace the ones in dst.
+// Replacing a map by a value of another type, or the other way around, is considered a conflict
+func mergeValues(dst, src map[string]any, path string) error {
+	for key, srcValue := range src {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+		dstValue, exists := dst[key]
+		if !exists || dstValue == nil || srcValue == nil {
+			dst[key] = copyValue(srcValue)
+			continue
+		}
+		dstMap, dstIsMap := dstValue.(map[string]any)
+		srcMap, srcIsMap := srcValue.(map[string]any)
+		switch {
+		case dstIsMap && srcIsMap:
+			if err := mergeValues(dstMap, srcMap, keyPath); err != nil {
+				return err
+			}
+		case dstIsMap != srcIsMap:
+			return fmt.Errorf("conflicting types for key %s: %T and %T", keyPath, dstValue, srcValue)
+		default:
+			dst[key] = copyValue(srcValue)
+		}
+	}
+	return nil
+}
+
+// copyValues returns a deep copy of the given values, so they can be merged without modifying the original maps
+func copyValues(values map[string]any) map[string]any {
+	valuesCopy := make(map[string]any, len(values))
+	for key, value := range values {
+		valuesCopy[key] = copyValue(value)
+	}
+	return valuesCopy
+}
+
+func copyValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return copyValues(v)
+	case []any:
+		list := make([]any, len(v))
+		for i, item := range v {
+			list[i] = copyValue(item)
+		}
+		return list
+	}
+	return value
+}
@@ -15,7 +15,9 @@
 package measurements
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/rand"
 	"sync"
 
 	"github.com/cloud-bulldozer/go-commons/v2/indexers"
@@ -50,10 +52,13 @@ type BaseMeasurement struct {
 }
 
 type MeasurementWatcher struct {
-	restClient    *rest.RESTClient
-	name          string
-	resource      string
+	restClient *rest.RESTClient
+	name       string
+	resource   string
+	// namespace namespace watched, all the namespaces when not set
+	namespace     string
 	labelSelector string
+	fieldSelector string
 	handlers      *cache.ResourceEventHandlerFuncs
 }
 
@@ -65,15 +70,22 @@ func (bm *BaseMeasurement) startMeasurement(measurementWatchers []MeasurementWat
 	bm.watchers = make([]*watchers.Watcher, len(measurementWatchers))
 	for i, measurementWatcher := range measurementWatchers {
 		log.Infof("Creating %v latency watcher for %s", measurementWatcher.resource, bm.JobConfig.Name)
+		namespace := measurementWatcher.namespace
+		if namespace == "" {
+			namespace = corev1.NamespaceAll
+		}
 		bm.watchers[i] = watchers.NewWatcher(
 			measurementWatcher.restClient,
 			measurementWatcher.name,
 			measurementWatcher.resource,
-			corev1.NamespaceAll,
+			namespace,
 			func(options *metav1.ListOptions) {
 				if measurementWatcher.labelSelector != "" {
 					options.LabelSelector = measurementWatcher.labelSelector
 				}
+				if measurementWatcher.fieldSelector != "" {
+					options.FieldSelector = measurementWatcher.fieldSelector
+				}
 			},
 			nil,
 		)
@@ -86,6 +98,21 @@ func (bm *BaseMeasurement) startMeasurement(measurementWatchers []MeasurementWat
 	}
 }
 
+// runSelector returns the label selector of the objects of the benchmark run
+func (bm *BaseMeasurement) runSelector() string {
+	return fmt.Sprintf("kube-burner-runid=%v", bm.Runid)
+}
+
+// objectSelector returns the label selector of the benchmark objects measured, narrowed by the labelSelector of the measurement.
+// It's meant for the primary resource of the measurement, the supporting resources don't necessarily share its labels
+func (bm *BaseMeasurement) objectSelector() string {
+	selector := bm.runSelector()
+	if bm.Config.LabelSelector != "" {
+		selector += "," + bm.Config.LabelSelector
+	}
+	return selector
+}
+
 func (bm *BaseMeasurement) stopWatchers() {
 	for _, watcher := range bm.watchers {
 		watcher.StopWatcher()
@@ -106,7 +133,7 @@ func (bm *BaseMeasurement) StopMeasurement(normalizeMetrics func() float64, getL
 	}
 	for _, q := range bm.latencyQuantiles {
 		pq := q.(metrics.LatencyQuantiles)
-		log.Infof("%s: %v 99th: %v max: %v avg: %v", bm.JobConfig.Name, pq.QuantileName, pq.P99, pq.Max, pq.Avg)
+		log.Infof("%s: %v %s", bm.JobConfig.Name, pq.QuantileName, pq.Summary(1, ""))
 	}
 	if errorRate > 0 {
 		log.Infof("%v error rate was: %.2f", bm.MeasurementName, errorRate)
@@ -118,14 +145,60 @@ func (bm *BaseMeasurement) GetMetrics() *sync.Map {
 	return &bm.metrics
 }
 
+// GetQuantiles returns the latency quantiles calculated when the measurement was stopped
+func (bm *BaseMeasurement) GetQuantiles() []any {
+	return bm.latencyQuantiles
+}
+
 func (bm *BaseMeasurement) Index(jobName string, indexerList map[string]indexers.Indexer) {
 	metricMap := map[string][]any{
-		bm.MeasurementName:          bm.normLatencies,
+		bm.MeasurementName:          bm.rawLatencies(),
 		bm.QuantilesMeasurementName: bm.latencyQuantiles,
 	}
 	bm.indexLatencyMeasurement(jobName, metricMap, indexerList)
 }
 
+// rawLatencies returns the per-object latency documents to index. When rawSamples is configured, the documents are sampled
+// and capped, and each of them records the fraction of documents indexed in samplingFraction, so it can be corrected for
+func (bm *BaseMeasurement) rawLatencies() []any {
+	rs := bm.Config.RawSamples
+	if rs == nil || len(bm.normLatencies) == 0 {
+		return bm.normLatencies
+	}
+	samples := make([]any, 0, len(bm.normLatencies))
+	for _, normLatency := range bm.normLatencies {
+		if rs.MaxSamples > 0 && len(samples) >= rs.MaxSamples {
+			break
+		}
+		if rs.SampleRate > 0 && rand.Float64() >= rs.SampleRate {
+			continue
+		}
+		samples = append(samples, normLatency)
+	}
+	samplingFraction := float64(len(samples)) / float64(len(bm.normLatencies))
+	log.Infof("%s: indexing %d out of %d raw samples", bm.MeasurementName, len(samples), len(bm.normLatencies))
+	for i, sample := range samples {
+		doc, err := toDocument(sample)
+		if err != nil {
+			log.Errorf("Error converting %s sample: %v", bm.MeasurementName, err)
+			continue
+		}
+		doc["samplingFraction"] = samplingFraction
+		samples[i] = doc
+	}
+	return samples
+}
+
+// toDocument converts a metric into a generic document
+func toDocument(metric any) (map[string]any, error) {
+	b, err := json.Marshal(metric)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	return doc, json.Unmarshal(b, &doc)
+}
+
 // Keep this method to allow reuse when overriding Index
 func (bm *BaseMeasurement) indexLatencyMeasurement(jobName string, metricMap map[string][]any, indexerList map[string]indexers.Indexer) {
 	indexDocuments := func(indexer indexers.Indexer, metricName string, data []any) {
@@ -143,10 +216,11 @@ func (bm *BaseMeasurement) indexLatencyMeasurement(jobName string, metricMap map
 	}
 	for metricName, data := range metricMap {
 		// Use the configured TimeseriesIndexer or QuantilesIndexer when specified or else use all indexers
-		if bm.Config.TimeseriesIndexer != "" && (metricName == podLatencyMeasurement || metricName == svcLatencyMeasurement || metricName == nodeLatencyMeasurement || metricName == pvcLatencyMeasurement) {
+		// Raw samples are indexed to all the indexers
+		if bm.Config.TimeseriesIndexer != "" && bm.Config.RawSamples == nil && (metricName == podLatencyMeasurement || metricName == svcLatencyMeasurement || metricName == nodeLatencyMeasurement || metricName == pvcLatencyMeasurement) {
 			indexer := indexerList[bm.Config.TimeseriesIndexer]
 			indexDocuments(indexer, metricName, data)
-		} else if bm.Config.QuantilesIndexer != "" && (metricName == podLatencyQuantilesMeasurement || metricName == svcLatencyQuantilesMeasurement || metricName == nodeLatencyQuantilesMeasurement || metricName == pvcLatencyQuantilesMeasurement) {
+		} else if bm.Config.QuantilesIndexer != "" && (metricName == podLatencyQuantilesMeasurement || metricName == svcLatencyQuantilesMeasurement || metricName == nodeLatencyQuantilesMeasurement || metricName == pvcLatencyQuantilesMeasurement || metricName == podLatencyNamespaceQuantilesMeasurement) {
 			indexer := indexerList[bm.Config.QuantilesIndexer]
 			indexDocuments(indexer, metricName, data)
 		} else {
@@ -168,7 +242,7 @@ func (bm *BaseMeasurement) calculateQuantiles(getLatency func(any) map[string]fl
 		}
 	}
 	calcSummary := func(name string, inputLatencies []float64) metrics.LatencyQuantiles {
-		latencySummary := metrics.NewLatencySummary(inputLatencies, name)
+		latencySummary := metrics.NewLatencySummary(inputLatencies, name, bm.Config.Quantiles...)
 		latencySummary.UUID = bm.Uuid
 		latencySummary.Metadata = bm.Metadata
 		latencySummary.MetricName = bm.QuantilesMeasurementName
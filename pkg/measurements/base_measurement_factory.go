@@ -16,9 +16,11 @@ package measurements
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/metrics"
 	"github.com/kube-burner/kube-burner/pkg/measurements/types"
 	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
 	"golang.org/x/exp/maps"
@@ -79,6 +81,9 @@ func verifyMeasurementConfig(config types.Measurement, supportedConditions map[s
 		if _, supported := supportedConditions[th.ConditionType]; !supported {
 			return fmt.Errorf("unsupported condition type in measurement: %s", th.ConditionType)
 		}
+		if slices.ContainsFunc(config.Quantiles, func(q float64) bool { return metrics.QuantileName(q) == th.Metric }) {
+			continue
+		}
 		if _, supportedLatency := supportedLatencyMetricsMap[th.Metric]; !supportedLatency {
 			return fmt.Errorf("unsupported metric %s in measurement, supported are: %s", th.Metric, strings.Join(maps.Keys(supportedLatencyMetricsMap), ", "))
 		}
@@ -0,0 +1,47 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"testing"
+
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+)
+
+func TestVerifyMeasurementConfigQuantileThresholds(t *testing.T) {
+	supportedConditions := map[string]struct{}{"Ready": {}}
+	tests := []struct {
+		name      string
+		quantiles []float64
+		metric    string
+		expectErr bool
+	}{
+		{"default metric", nil, "P99", false},
+		{"custom quantile", []float64{0.999}, "P999", false},
+		{"custom quantile with float artifacts", []float64{0.29}, "P29", false},
+		{"quantile not configured", []float64{0.29}, "P57", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			measurement := types.Measurement{
+				Quantiles:         tt.quantiles,
+				LatencyThresholds: []types.LatencyThreshold{{ConditionType: "Ready", Metric: tt.metric}},
+			}
+			if err := verifyMeasurementConfig(measurement, supportedConditions); (err != nil) != tt.expectErr {
+				t.Errorf("expected error %v, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
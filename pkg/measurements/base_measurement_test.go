@@ -0,0 +1,36 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"testing"
+
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+)
+
+func TestObjectSelector(t *testing.T) {
+	bm := BaseMeasurement{Runid: "runid", Config: types.Measurement{LabelSelector: "app=workload"}}
+	if got := bm.objectSelector(); got != "kube-burner-runid=runid,app=workload" {
+		t.Errorf("unexpected object selector: %s", got)
+	}
+	// Supporting objects aren't narrowed by the labelSelector of the measurement
+	if got := bm.runSelector(); got != "kube-burner-runid=runid" {
+		t.Errorf("unexpected run selector: %s", got)
+	}
+	bm.Config.LabelSelector = ""
+	if got := bm.objectSelector(); got != "kube-burner-runid=runid" {
+		t.Errorf("unexpected object selector without labelSelector: %s", got)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured/unstructuredscheme"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -115,3 +116,16 @@ func getGroupVersionClient(restConfig *rest.Config, gv schema.GroupVersion, type
 	}
 	return cdiClient
 }
+
+// getUnstructuredClient returns a REST client of the given group version decoding the objects as unstructured
+func getUnstructuredClient(restConfig *rest.Config, gv schema.GroupVersion) (*rest.RESTClient, error) {
+	shallowCopy := *restConfig
+	shallowCopy.GroupVersion = &gv
+	shallowCopy.APIPath = "/apis"
+	if gv.Group == "" {
+		shallowCopy.APIPath = "/api"
+	}
+	shallowCopy.NegotiatedSerializer = unstructuredscheme.NewUnstructuredNegotiatedSerializer()
+	shallowCopy.UserAgent = rest.DefaultKubernetesUserAgent()
+	return rest.RESTClientFor(&shallowCopy)
+}
@@ -0,0 +1,321 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	configPropagationLatencyMeasurement          = "configPropagationLatencyMeasurement"
+	configPropagationLatencyQuantilesMeasurement = "configPropagationLatencyQuantilesMeasurement"
+	configPropagated                             = "Propagated"
+	defaultPropagationVersionKey                 = "version"
+	defaultPropagationAnnotation                 = "kube-burner.io/config-version"
+	secretKind                                   = "Secret"
+	configMapKind                                = "ConfigMap"
+)
+
+var (
+	supportedConfigPropagationConditions = map[string]struct{}{
+		configPropagated: {},
+	}
+)
+
+// configPropagationMetric holds the propagation latency of a Secret or ConfigMap update to a pod mounting it
+type configPropagationMetric struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Propagated whether the pod reported the updated version before the end of the job
+	Propagated         bool   `json:"propagated"`
+	PropagationLatency int    `json:"propagationLatency"`
+	Version            string `json:"version"`
+	UUID               string `json:"uuid"`
+	JobName            string `json:"jobName,omitempty"`
+	MetricName         string `json:"metricName"`
+	Kind               string `json:"kind"`
+	Namespace          string `json:"namespace"`
+	Name               string `json:"name"`
+	PodName            string `json:"podName"`
+	JobIteration       int    `json:"jobIteration"`
+	Replica            int    `json:"replica"`
+	Metadata           any    `json:"metadata,omitempty"`
+}
+
+// propagationObject identifies a Secret or ConfigMap
+type propagationObject struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// propagationPod holds the tracked objects mounted by a pod, and the time each version was first reported by it
+type propagationPod struct {
+	name         string
+	created      time.Time
+	jobIteration int
+	replica      int
+	mounts       []propagationObject
+	reported     map[string]time.Time
+}
+
+type configPropagationLatency struct {
+	BaseMeasurement
+	mu sync.Mutex
+	// updates update time of each version, indexed by the updated object
+	updates map[propagationObject]map[string]time.Time
+	// pods pods mounting Secrets or ConfigMaps indexed by pod UID
+	pods map[string]*propagationPod
+}
+
+type configPropagationLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newConfigPropagationLatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedConfigPropagationConditions); err != nil {
+		return nil, err
+	}
+	if measurement.ConfigPropagation.VersionKey == "" {
+		measurement.ConfigPropagation.VersionKey = defaultPropagationVersionKey
+	}
+	if measurement.ConfigPropagation.Annotation == "" {
+		measurement.ConfigPropagation.Annotation = defaultPropagationAnnotation
+	}
+	return configPropagationLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (cplmf configPropagationLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &configPropagationLatency{
+		BaseMeasurement: cplmf.NewBaseLatency(jobConfig, clientSet, restConfig, configPropagationLatencyMeasurement, configPropagationLatencyQuantilesMeasurement, embedCfg),
+	}
+}
+
+// configVersion returns the Secret or ConfigMap and its version, the version is the value of the configured key
+func (c *configPropagationLatency) configVersion(obj any) (propagationObject, string) {
+	versionKey := c.Config.ConfigPropagation.VersionKey
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		version := string(o.Data[versionKey])
+		if version == "" {
+			version = o.StringData[versionKey]
+		}
+		return propagationObject{secretKind, o.Namespace, o.Name}, version
+	case *corev1.ConfigMap:
+		version := o.Data[versionKey]
+		if version == "" {
+			version = string(o.BinaryData[versionKey])
+		}
+		return propagationObject{configMapKind, o.Namespace, o.Name}, version
+	}
+	return propagationObject{}, ""
+}
+
+// handleConfigUpdate records the time a new version of a Secret or ConfigMap is observed
+func (c *configPropagationLatency) handleConfigUpdate(oldObj, newObj any) {
+	key, version := c.configVersion(newObj)
+	if _, oldVersion := c.configVersion(oldObj); version == "" || version == oldVersion {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.updates[key] == nil {
+		c.updates[key] = map[string]time.Time{}
+	}
+	if _, exists := c.updates[key][version]; !exists {
+		log.Tracef("%s %s/%s updated to version %s", key.kind, key.namespace, key.name, version)
+		c.updates[key][version] = time.Now().UTC()
+	}
+}
+
+// handlePod records the Secrets and ConfigMaps mounted by the pod, and the first time it reports each version through the configured annotation
+func (c *configPropagationLatency) handlePod(obj any) {
+	pod := obj.(*corev1.Pod)
+	now := time.Now().UTC()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, exists := c.pods[string(pod.UID)]
+	if !exists {
+		var mounts []propagationObject
+		for _, volume := range pod.Spec.Volumes {
+			switch {
+			case volume.Secret != nil:
+				mounts = append(mounts, propagationObject{secretKind, pod.Namespace, volume.Secret.SecretName})
+			case volume.ConfigMap != nil:
+				mounts = append(mounts, propagationObject{configMapKind, pod.Namespace, volume.ConfigMap.Name})
+			case volume.Projected != nil:
+				for _, source := range volume.Projected.Sources {
+					if source.Secret != nil {
+						mounts = append(mounts, propagationObject{secretKind, pod.Namespace, source.Secret.Name})
+					}
+					if source.ConfigMap != nil {
+						mounts = append(mounts, propagationObject{configMapKind, pod.Namespace, source.ConfigMap.Name})
+					}
+				}
+			}
+		}
+		if len(mounts) == 0 {
+			return
+		}
+		p = &propagationPod{
+			name:         pod.Name,
+			created:      pod.CreationTimestamp.UTC(),
+			jobIteration: getIntFromLabels(pod.Labels, config.KubeBurnerLabelJobIteration),
+			replica:      getIntFromLabels(pod.Labels, config.KubeBurnerLabelReplica),
+			mounts:       mounts,
+			reported:     map[string]time.Time{},
+		}
+		c.pods[string(pod.UID)] = p
+	}
+	version := pod.Annotations[c.Config.ConfigPropagation.Annotation]
+	if _, reported := p.reported[version]; version != "" && !reported {
+		log.Tracef("Pod %s/%s reported version %s", pod.Namespace, pod.Name, version)
+		p.reported[version] = now
+	}
+}
+
+// start configPropagationLatency measurement
+func (c *configPropagationLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	c.updates = map[propagationObject]map[string]time.Time{}
+	c.pods = map[string]*propagationPod{}
+	configHandlers := &cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.handleConfigUpdate,
+	}
+	c.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient:    c.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "podWatcher",
+				resource:      "pods",
+				labelSelector: c.objectSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: c.handlePod,
+					UpdateFunc: func(oldObj, newObj any) {
+						c.handlePod(newObj)
+					},
+				},
+			},
+			{
+				restClient:    c.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "secretWatcher",
+				resource:      "secrets",
+				labelSelector: c.runSelector(),
+				handlers:      configHandlers,
+			},
+			{
+				restClient:    c.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "configMapWatcher",
+				resource:      "configmaps",
+				labelSelector: c.runSelector(),
+				handlers:      configHandlers,
+			},
+		},
+	)
+	return nil
+}
+
+// collects configPropagationLatency measurements triggered in the past
+func (c *configPropagationLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to configPropagationLatency by design")
+	defer measurementWg.Done()
+}
+
+// stop configPropagationLatency measurement
+func (c *configPropagationLatency) Stop() error {
+	return c.StopMeasurement(c.normalizeMetrics, c.getLatency)
+}
+
+// normalizeMetrics calculates the propagation latency of each update to the pods mounting the updated object, and returns
+// the percentage of updates never reported by a pod. Pods created after an update aren't accounted for it, neither are
+// pods which reported a later version of the object, since the kubelet can skip versions within the same sync period
+func (c *configPropagationLatency) normalizeMetrics() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var total, notPropagated int
+	for _, p := range c.pods {
+		for _, key := range p.mounts {
+			for version, updated := range c.updates[key] {
+				if p.created.After(updated) {
+					continue
+				}
+				m := configPropagationMetric{
+					Timestamp:    updated,
+					Version:      version,
+					UUID:         c.Uuid,
+					JobName:      c.JobConfig.Name,
+					MetricName:   configPropagationLatencyMeasurement,
+					Kind:         key.kind,
+					Namespace:    key.namespace,
+					Name:         key.name,
+					PodName:      p.name,
+					JobIteration: p.jobIteration,
+					Replica:      p.replica,
+					Metadata:     c.Metadata,
+				}
+				if reported, ok := p.reported[version]; ok {
+					m.Propagated = true
+					// Pod and Secret or ConfigMap events are received by different watchers, so the pod can report the version first
+					m.PropagationLatency = max(0, int(reported.Sub(updated).Milliseconds()))
+				} else if c.supersededVersion(p, key, updated) {
+					continue
+				} else {
+					notPropagated++
+					log.Debugf("Version %s of %s %s/%s wasn't reported by pod %s", version, key.kind, key.namespace, key.name, p.name)
+				}
+				total++
+				c.normLatencies = append(c.normLatencies, m)
+			}
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	if notPropagated > 0 {
+		log.Errorf("%d out of %d updates weren't reported by the mounting pods", notPropagated, total)
+	}
+	return float64(notPropagated) / float64(total) * 100
+}
+
+// supersededVersion returns true when the pod reported a version of the object updated after the given time
+func (c *configPropagationLatency) supersededVersion(p *propagationPod, key propagationObject, updated time.Time) bool {
+	for version, t := range c.updates[key] {
+		if _, ok := p.reported[version]; ok && t.After(updated) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *configPropagationLatency) getLatency(normLatency any) map[string]float64 {
+	m := normLatency.(configPropagationMetric)
+	if !m.Propagated {
+		return map[string]float64{}
+	}
+	return map[string]float64{
+		configPropagated: float64(m.PropagationLatency),
+	}
+}
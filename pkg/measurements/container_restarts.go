@@ -0,0 +1,207 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	containerRestartsMeasurement        = "containerRestartsMeasurement"
+	containerRestartsSummaryMeasurement = "containerRestartsSummary"
+)
+
+// containerRestartMetric holds the restarts of a container, only containers which restarted are indexed
+type containerRestartMetric struct {
+	Timestamp             time.Time `json:"timestamp"`
+	UUID                  string    `json:"uuid"`
+	JobName               string    `json:"jobName,omitempty"`
+	MetricName            string    `json:"metricName"`
+	Namespace             string    `json:"namespace"`
+	PodName               string    `json:"podName"`
+	ContainerName         string    `json:"containerName"`
+	InitContainer         bool      `json:"initContainer"`
+	Image                 string    `json:"image"`
+	NodeName              string    `json:"nodeName"`
+	Restarts              int32     `json:"restarts"`
+	LastTerminationReason string    `json:"lastTerminationReason,omitempty"`
+	LastExitCode          int32     `json:"lastExitCode,omitempty"`
+	JobIteration          int       `json:"jobIteration"`
+	Replica               int       `json:"replica"`
+	Metadata              any       `json:"metadata,omitempty"`
+}
+
+// containerRestartsSummary tallies the container restarts of the job
+type containerRestartsSummary struct {
+	Timestamp         time.Time `json:"timestamp"`
+	UUID              string    `json:"uuid"`
+	JobName           string    `json:"jobName,omitempty"`
+	MetricName        string    `json:"metricName"`
+	Restarts          int32     `json:"restarts"`
+	RestartedPods     int       `json:"restartedPods"`
+	RestartThreshold  *int      `json:"restartThreshold,omitempty"`
+	ThresholdExceeded bool      `json:"thresholdExceeded"`
+	Metadata          any       `json:"metadata,omitempty"`
+}
+
+type containerRestarts struct {
+	BaseMeasurement
+	restartMetrics []any
+	summary        []any
+}
+
+type containerRestartsMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newContainerRestartsMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	return containerRestartsMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (crmf containerRestartsMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &containerRestarts{
+		BaseMeasurement: crmf.NewBaseLatency(jobConfig, clientSet, restConfig, containerRestartsMeasurement, containerRestartsSummaryMeasurement, embedCfg),
+	}
+}
+
+// handlePod records the restarts of the pod containers, the highest restart count observed is kept
+// so restarts of pods deleted during the job, i.e. by churn, are accounted as well
+func (c *containerRestarts) handlePod(obj any) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+	record := func(cs corev1.ContainerStatus, initContainer bool) {
+		if cs.RestartCount == 0 {
+			return
+		}
+		key := fmt.Sprintf("%s/%s", pod.UID, cs.Name)
+		if value, exists := c.metrics.Load(key); exists && value.(containerRestartMetric).Restarts >= cs.RestartCount {
+			return
+		}
+		podLabels := pod.GetLabels()
+		metric := containerRestartMetric{
+			Timestamp:     time.Now().UTC(),
+			UUID:          c.Uuid,
+			JobName:       c.JobConfig.Name,
+			MetricName:    containerRestartsMeasurement,
+			Namespace:     pod.Namespace,
+			PodName:       pod.Name,
+			ContainerName: cs.Name,
+			InitContainer: initContainer,
+			Image:         cs.Image,
+			NodeName:      pod.Spec.NodeName,
+			Restarts:      cs.RestartCount,
+			JobIteration:  getIntFromLabels(podLabels, config.KubeBurnerLabelJobIteration),
+			Replica:       getIntFromLabels(podLabels, config.KubeBurnerLabelReplica),
+			Metadata:      c.Metadata,
+		}
+		if terminated := cs.LastTerminationState.Terminated; terminated != nil {
+			metric.LastTerminationReason = terminated.Reason
+			metric.LastExitCode = terminated.ExitCode
+		}
+		log.Debugf("Container %s from pod %s/%s restarted %d times", cs.Name, pod.Namespace, pod.Name, cs.RestartCount)
+		c.metrics.Store(key, metric)
+	}
+	for _, cs := range pod.Status.InitContainerStatuses {
+		record(cs, true)
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		record(cs, false)
+	}
+}
+
+// start containerRestarts measurement
+func (c *containerRestarts) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	c.restartMetrics, c.summary = nil, nil
+	c.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient:    c.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "containerRestartsWatcher",
+				resource:      "pods",
+				labelSelector: c.objectSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: c.handlePod,
+					UpdateFunc: func(oldObj, newObj any) {
+						c.handlePod(newObj)
+					},
+				},
+			},
+		},
+	)
+	return nil
+}
+
+// collects container restarts triggered in the past
+func (c *containerRestarts) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to containerRestarts by design")
+	defer measurementWg.Done()
+}
+
+// Stop tallies the container restarts, returning an error when they exceed the configured threshold
+func (c *containerRestarts) Stop() error {
+	defer c.stopWatchers()
+	summary := containerRestartsSummary{
+		Timestamp:        time.Now().UTC(),
+		UUID:             c.Uuid,
+		JobName:          c.JobConfig.Name,
+		MetricName:       containerRestartsSummaryMeasurement,
+		RestartThreshold: c.Config.RestartThreshold,
+		Metadata:         c.Metadata,
+	}
+	restartedPods := make(map[string]bool)
+	c.metrics.Range(func(key, value any) bool {
+		m := value.(containerRestartMetric)
+		summary.Restarts += m.Restarts
+		restartedPods[m.Namespace+"/"+m.PodName] = true
+		c.restartMetrics = append(c.restartMetrics, m)
+		return true
+	})
+	summary.RestartedPods = len(restartedPods)
+	log.Infof("%s: %d container restarts in %d pods", c.JobConfig.Name, summary.Restarts, summary.RestartedPods)
+	var err error
+	if c.Config.RestartThreshold != nil && int(summary.Restarts) > *c.Config.RestartThreshold {
+		summary.ThresholdExceeded = true
+		err = fmt.Errorf("%s: %d container restarts exceed the threshold of %d restarts", c.JobConfig.Name, summary.Restarts, *c.Config.RestartThreshold)
+	}
+	c.summary = []any{summary}
+	return err
+}
+
+func (c *containerRestarts) Index(jobName string, indexerList map[string]indexers.Indexer) {
+	metricMap := map[string][]any{
+		containerRestartsSummaryMeasurement: c.summary,
+	}
+	if len(c.restartMetrics) > 0 {
+		metricMap[containerRestartsMeasurement] = c.restartMetrics
+	}
+	c.indexLatencyMeasurement(jobName, metricMap, indexerList)
+}
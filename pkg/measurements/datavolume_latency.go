@@ -16,7 +16,6 @@ package measurements
 
 import (
 	"context"
-	"fmt"
 	"strings"
 	"sync"
 	"time"
@@ -161,7 +160,7 @@ func (dv *dvLatency) Start(measurementWg *sync.WaitGroup) error {
 				restClient:    getGroupVersionClient(dv.RestConfig, cdiv1beta1.SchemeGroupVersion, &cdiv1beta1.DataVolumeList{}, &cdiv1beta1.DataVolume{}),
 				name:          "dvWatcher",
 				resource:      "datavolumes",
-				labelSelector: fmt.Sprintf("kube-burner-runid=%v", dv.Runid),
+				labelSelector: dv.objectSelector(),
 				handlers: &cache.ResourceEventHandlerFuncs{
 					AddFunc: dv.handleCreateDV,
 					UpdateFunc: func(oldObj, newObj any) {
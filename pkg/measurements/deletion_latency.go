@@ -0,0 +1,292 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	deletionLatencyMeasurement          = "deletionLatencyMeasurement"
+	deletionLatencyQuantilesMeasurement = "deletionLatencyQuantilesMeasurement"
+	objectDeleted                       = "ObjectDeleted"
+)
+
+var (
+	supportedDeletionConditions = map[string]struct{}{
+		objectDeleted: {},
+	}
+)
+
+// finalizerLatency time elapsed since the deletion was requested until the finalizer was removed
+type finalizerLatency struct {
+	Name    string `json:"name"`
+	Latency int    `json:"latency"`
+}
+
+// deletionMetric holds the time elapsed since the deletion of an object was requested until it disappeared from the API
+type deletionMetric struct {
+	Timestamp         time.Time `json:"timestamp"`
+	gone              time.Time
+	pendingFinalizers []string
+	DeletionLatency   int                `json:"deletionLatency"`
+	Deleted           bool               `json:"deleted"`
+	Finalizers        []finalizerLatency `json:"finalizers,omitempty"`
+	BlockingFinalizer string             `json:"blockingFinalizer,omitempty"`
+	UUID              string             `json:"uuid"`
+	JobName           string             `json:"jobName,omitempty"`
+	MetricName        string             `json:"metricName"`
+	Kind              string             `json:"kind"`
+	Namespace         string             `json:"namespace,omitempty"`
+	Name              string             `json:"name"`
+	Metadata          any                `json:"metadata,omitempty"`
+}
+
+type deletionLatency struct {
+	BaseMeasurement
+	stopCh chan struct{}
+}
+
+type deletionLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newDeletionLatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedDeletionConditions); err != nil {
+		return nil, err
+	}
+	return deletionLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (dlmf deletionLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &deletionLatency{
+		BaseMeasurement: dlmf.NewBaseLatency(jobConfig, clientSet, restConfig, deletionLatencyMeasurement, deletionLatencyQuantilesMeasurement, embedCfg),
+	}
+}
+
+// handleUpdate records the deletion request of an object the first time it's observed, and the removal of its finalizers
+func (d *deletionLatency) handleUpdate(obj any) {
+	item, ok := obj.(*unstructured.Unstructured)
+	if !ok || item.GetDeletionTimestamp() == nil {
+		return
+	}
+	now := time.Now().UTC()
+	value, _ := d.metrics.LoadOrStore(string(item.GetUID()), deletionMetric{
+		Timestamp:         deletionStart(item, now),
+		pendingFinalizers: item.GetFinalizers(),
+		UUID:              d.Uuid,
+		JobName:           d.JobConfig.Name,
+		MetricName:        deletionLatencyMeasurement,
+		Kind:              item.GetKind(),
+		Namespace:         item.GetNamespace(),
+		Name:              item.GetName(),
+		Metadata:          d.Metadata,
+	})
+	dm := value.(deletionMetric)
+	if d.removeFinalizers(&dm, item.GetFinalizers(), now) {
+		d.metrics.Store(string(item.GetUID()), dm)
+	}
+}
+
+// handleDelete records the time the object disappeared from the API. Objects deleted without
+// going through a graceful deletion, i.e. without finalizers, are reported with 0 latency
+func (d *deletionLatency) handleDelete(obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	item, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	now := time.Now().UTC()
+	value, exists := d.metrics.Load(string(item.GetUID()))
+	if !exists {
+		value = deletionMetric{
+			Timestamp:  deletionStart(item, now),
+			UUID:       d.Uuid,
+			JobName:    d.JobConfig.Name,
+			MetricName: deletionLatencyMeasurement,
+			Kind:       item.GetKind(),
+			Namespace:  item.GetNamespace(),
+			Name:       item.GetName(),
+			Metadata:   d.Metadata,
+		}
+	}
+	dm := value.(deletionMetric)
+	d.removeFinalizers(&dm, nil, now)
+	dm.gone = now
+	log.Tracef("%s %s/%s deleted", dm.Kind, dm.Namespace, dm.Name)
+	d.metrics.Store(string(item.GetUID()), dm)
+}
+
+// deletionStart returns the time the deletion of an object was requested, its deletionTimestamp minus the grace period,
+// as the deletion can be observed much later than requested. Objects without deletionTimestamp are considered requested now
+func deletionStart(item *unstructured.Unstructured, now time.Time) time.Time {
+	deletionTimestamp := item.GetDeletionTimestamp()
+	if deletionTimestamp == nil {
+		return now
+	}
+	start := deletionTimestamp.UTC()
+	if gracePeriod := item.GetDeletionGracePeriodSeconds(); gracePeriod != nil {
+		start = start.Add(-time.Duration(*gracePeriod) * time.Second)
+	}
+	// deletionTimestamp has second precision
+	if start.After(now) {
+		return now
+	}
+	return start
+}
+
+// removeFinalizers records the pending finalizers not present in the given list as removed, returns true when any was removed
+func (d *deletionLatency) removeFinalizers(dm *deletionMetric, finalizers []string, now time.Time) bool {
+	removed := false
+	pending := dm.pendingFinalizers[:0:0]
+	for _, finalizer := range dm.pendingFinalizers {
+		if slices.Contains(finalizers, finalizer) {
+			pending = append(pending, finalizer)
+			continue
+		}
+		log.Tracef("Finalizer %s removed from %s %s/%s", finalizer, dm.Kind, dm.Namespace, dm.Name)
+		dm.Finalizers = append(dm.Finalizers, finalizerLatency{Name: finalizer, Latency: int(now.Sub(dm.Timestamp).Milliseconds())})
+		removed = true
+	}
+	dm.pendingFinalizers = pending
+	return removed
+}
+
+// start deletionLatency measurement
+func (d *deletionLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	if d.JobConfig.JobType != config.DeletionJob {
+		log.Fatalf("Unsupported jobType:%s for deletionLatency metric", d.JobConfig.JobType)
+	}
+	d.latencyQuantiles, d.normLatencies = nil, nil
+	d.metrics = sync.Map{}
+	d.stopCh = make(chan struct{})
+	apiGroupResources, err := restmapper.GetAPIGroupResources(discovery.NewDiscoveryClientForConfigOrDie(d.RestConfig))
+	if err != nil {
+		log.Fatalf("Error getting API group resources: %v", err)
+	}
+	mapper := restmapper.NewDiscoveryRESTMapper(apiGroupResources)
+	dynamicClient := dynamic.NewForConfigOrDie(d.RestConfig)
+	for _, obj := range d.JobConfig.Objects {
+		apiVersion := obj.APIVersion
+		if apiVersion == "" {
+			apiVersion = "v1"
+		}
+		mapping, err := mapper.RESTMapping(schema.FromAPIVersionAndKind(apiVersion, obj.Kind).GroupKind())
+		if err != nil {
+			log.Fatal(err)
+		}
+		labelSelector, fieldSelector := labels.Set(obj.LabelSelector).String(), fields.Set(obj.FieldSelector).String()
+		log.Infof("Creating %v deletion latency watcher for %s", mapping.Resource.Resource, d.JobConfig.Name)
+		informer := dynamicinformer.NewFilteredDynamicInformer(dynamicClient, mapping.Resource, metav1.NamespaceAll, 0, cache.Indexers{}, func(options *metav1.ListOptions) {
+			options.LabelSelector = labelSelector
+			options.FieldSelector = fieldSelector
+		}).Informer()
+		informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj any) {
+				d.handleUpdate(newObj)
+			},
+			DeleteFunc: d.handleDelete,
+		})
+		go informer.Run(d.stopCh)
+		if !cache.WaitForCacheSync(d.stopCh, informer.HasSynced) {
+			log.Errorf("%v deletion latency measurement error: timed out waiting for caches to sync", mapping.Resource.Resource)
+		}
+	}
+	return nil
+}
+
+// collects deletion measurements triggered in the past
+func (d *deletionLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to deletionLatency by design")
+	defer measurementWg.Done()
+}
+
+// stop deletion latency measurement
+func (d *deletionLatency) Stop() error {
+	defer close(d.stopCh)
+	return d.StopMeasurement(d.normalizeMetrics, d.getLatency)
+}
+
+// normalizeMetrics calculates the deletion latencies, objects still present are reported as not deleted,
+// with the latency until now, and the finalizers they're waiting for as blocking
+func (d *deletionLatency) normalizeMetrics() float64 {
+	stuckObjects := 0
+	now := time.Now().UTC()
+	d.metrics.Range(func(key, value any) bool {
+		m := value.(deletionMetric)
+		end := m.gone
+		m.Deleted = !m.gone.IsZero()
+		if !m.Deleted {
+			end = now
+			stuckObjects++
+			if len(m.pendingFinalizers) > 0 {
+				m.BlockingFinalizer = m.pendingFinalizers[0]
+			}
+		} else {
+			var maxLatency int
+			for _, f := range m.Finalizers {
+				if f.Latency >= maxLatency {
+					maxLatency, m.BlockingFinalizer = f.Latency, f.Name
+				}
+			}
+		}
+		m.DeletionLatency = int(end.Sub(m.Timestamp).Milliseconds())
+		d.normLatencies = append(d.normLatencies, m)
+		return true
+	})
+	if stuckObjects > 0 {
+		log.Warnf("%s: %d objects were not deleted", d.JobConfig.Name, stuckObjects)
+	}
+	return 0
+}
+
+// getLatency returns the deletion latency and the latency of each removed finalizer, objects not deleted are not accounted
+func (d *deletionLatency) getLatency(normLatency any) map[string]float64 {
+	deletionMetric := normLatency.(deletionMetric)
+	if !deletionMetric.Deleted {
+		return nil
+	}
+	latencies := map[string]float64{
+		objectDeleted: float64(deletionMetric.DeletionLatency),
+	}
+	for _, f := range deletionMetric.Finalizers {
+		latencies[f.Name] = float64(f.Latency)
+	}
+	return latencies
+}
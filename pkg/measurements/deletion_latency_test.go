@@ -0,0 +1,98 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDeletionStart(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 1, 0, 500*int(time.Millisecond), time.UTC)
+	tests := []struct {
+		name              string
+		deletionTimestamp *time.Time
+		gracePeriod       *int64
+		expected          time.Time
+	}{
+		{
+			name:     "no deletionTimestamp",
+			expected: now,
+		},
+		{
+			name:              "without grace period",
+			deletionTimestamp: &[]time.Time{now.Add(-10 * time.Second).Truncate(time.Second)}[0],
+			expected:          now.Add(-10 * time.Second).Truncate(time.Second),
+		},
+		{
+			name:              "grace period subtracted",
+			deletionTimestamp: &[]time.Time{now.Add(20 * time.Second).Truncate(time.Second)}[0],
+			gracePeriod:       gracePeriodSeconds(30),
+			expected:          now.Add(-10 * time.Second).Truncate(time.Second),
+		},
+		{
+			name:              "not after now",
+			deletionTimestamp: &[]time.Time{now.Add(30 * time.Second)}[0],
+			gracePeriod:       gracePeriodSeconds(0),
+			expected:          now,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := &unstructured.Unstructured{Object: map[string]any{}}
+			if tt.deletionTimestamp != nil {
+				deletionTimestamp := metav1.NewTime(*tt.deletionTimestamp)
+				item.SetDeletionTimestamp(&deletionTimestamp)
+			}
+			item.SetDeletionGracePeriodSeconds(tt.gracePeriod)
+			if got := deletionStart(item, now); !got.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDeletionLatencyFromRequest(t *testing.T) {
+	d := &deletionLatency{BaseMeasurement: BaseMeasurement{JobConfig: &config.Job{Name: "delete"}}}
+	requested := time.Now().UTC().Add(-5 * time.Second).Truncate(time.Second)
+	item := &unstructured.Unstructured{Object: map[string]any{}}
+	item.SetUID("uid")
+	item.SetKind("Pod")
+	item.SetName("pod")
+	deletionTimestamp := metav1.NewTime(requested.Add(30 * time.Second))
+	item.SetDeletionTimestamp(&deletionTimestamp)
+	item.SetDeletionGracePeriodSeconds(gracePeriodSeconds(30))
+	d.handleUpdate(item)
+	d.handleDelete(item)
+	d.normalizeMetrics()
+	if len(d.normLatencies) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(d.normLatencies))
+	}
+	dm := d.normLatencies[0].(deletionMetric)
+	if !dm.Timestamp.Equal(requested) {
+		t.Errorf("expected timestamp %v, got %v", requested, dm.Timestamp)
+	}
+	if !dm.Deleted || dm.DeletionLatency < 5000 {
+		t.Errorf("expected deleted object with latency >= 5000ms, got deleted=%v latency=%d", dm.Deleted, dm.DeletionLatency)
+	}
+}
+
+func gracePeriodSeconds(seconds int64) *int64 {
+	return &seconds
+}
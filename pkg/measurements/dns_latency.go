@@ -0,0 +1,210 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"cmp"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// DNSLatency name of the dnsLatency measurement, the pods created while it's enabled get the DNS probe
+	DNSLatency                     = "dnsLatency"
+	dnsLatencyMeasurement          = "dnsLatencyMeasurement"
+	dnsLatencyQuantilesMeasurement = "dnsLatencyQuantilesMeasurement"
+	dnsLookup                      = "DNSLookup"
+	// DNSProbeContainer name of the init container injected into the pods to time the DNS lookup
+	DNSProbeContainer = "kube-burner-dns-probe"
+	defaultDNSTarget  = "kubernetes.default.svc"
+	defaultDNSImage   = "quay.io/cloud-bulldozer/fedora-nc:latest"
+	// dnsProbeScript times a lookup of $TARGET and reports its latency in milliseconds along with its exit code through the
+	// termination message. The probe always succeeds, so failed lookups don't prevent the pods from starting
+	dnsProbeScript = `start=$(date +%s%N)
+getent hosts "$TARGET" > /dev/null
+rc=$?
+end=$(date +%s%N)
+echo "$(( (end - start) / 1000000 )) $rc" > /dev/termination-log`
+)
+
+var (
+	supportedDNSConditions = map[string]struct{}{
+		dnsLookup: {},
+	}
+)
+
+// dnsMetric holds the latency of the DNS lookup of the probe of a pod
+type dnsMetric struct {
+	// Timestamp time the probe started
+	Timestamp time.Time `json:"timestamp"`
+	// DNSLatency time taken by the lookup in milliseconds
+	DNSLatency int `json:"dnsLatency"`
+	// Resolved whether the lookup succeeded, failed lookups are excluded from the quantiles
+	Resolved     bool   `json:"resolved"`
+	Target       string `json:"target"`
+	UUID         string `json:"uuid"`
+	JobName      string `json:"jobName,omitempty"`
+	MetricName   string `json:"metricName"`
+	Namespace    string `json:"namespace"`
+	PodName      string `json:"podName"`
+	NodeName     string `json:"nodeName"`
+	JobIteration int    `json:"jobIteration"`
+	Replica      int    `json:"replica"`
+	Metadata     any    `json:"metadata,omitempty"`
+}
+
+// NewDNSProbe returns the init container timing the DNS lookup configured in the given dnsLatency measurement
+func NewDNSProbe(measurement types.Measurement) corev1.Container {
+	return corev1.Container{
+		Name:    DNSProbeContainer,
+		Image:   cmp.Or(measurement.DNS.Image, defaultDNSImage),
+		Command: []string{"/bin/sh", "-c", dnsProbeScript},
+		Env: []corev1.EnvVar{
+			{Name: "TARGET", Value: cmp.Or(measurement.DNS.Target, defaultDNSTarget)},
+		},
+	}
+}
+
+type dnsLatency struct {
+	BaseMeasurement
+	target string
+}
+
+type dnsLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newDNSLatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedDNSConditions); err != nil {
+		return nil, err
+	}
+	return dnsLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (dlmf dnsLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &dnsLatency{
+		BaseMeasurement: dlmf.NewBaseLatency(jobConfig, clientSet, restConfig, dnsLatencyMeasurement, dnsLatencyQuantilesMeasurement, embedCfg),
+		target:          cmp.Or(dlmf.Config.DNS.Target, defaultDNSTarget),
+	}
+}
+
+// handlePod records the result of the DNS probe once it terminates
+func (d *dnsLatency) handlePod(obj any) {
+	pod := obj.(*corev1.Pod)
+	if _, exists := d.metrics.Load(string(pod.UID)); exists {
+		return
+	}
+	for _, cs := range pod.Status.InitContainerStatuses {
+		if cs.Name != DNSProbeContainer || cs.State.Terminated == nil {
+			continue
+		}
+		var latency, rc int
+		if _, err := fmt.Sscanf(cs.State.Terminated.Message, "%d %d", &latency, &rc); err != nil {
+			log.Warnf("Invalid DNS probe result of pod %s/%s: %q", pod.Namespace, pod.Name, cs.State.Terminated.Message)
+			return
+		}
+		d.metrics.LoadOrStore(string(pod.UID), dnsMetric{
+			Timestamp:    cs.State.Terminated.StartedAt.UTC(),
+			DNSLatency:   latency,
+			Resolved:     rc == 0,
+			Target:       d.target,
+			UUID:         d.Uuid,
+			JobName:      d.JobConfig.Name,
+			MetricName:   dnsLatencyMeasurement,
+			Namespace:    pod.Namespace,
+			PodName:      pod.Name,
+			NodeName:     pod.Spec.NodeName,
+			JobIteration: getIntFromLabels(pod.Labels, config.KubeBurnerLabelJobIteration),
+			Replica:      getIntFromLabels(pod.Labels, config.KubeBurnerLabelReplica),
+			Metadata:     d.Metadata,
+		})
+	}
+}
+
+// start dnsLatency measurement
+func (d *dnsLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	d.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient:    d.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "podWatcher",
+				resource:      "pods",
+				labelSelector: d.objectSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: d.handlePod,
+					UpdateFunc: func(oldObj, newObj any) {
+						d.handlePod(newObj)
+					},
+				},
+			},
+		},
+	)
+	return nil
+}
+
+// collects dnsLatency measurements triggered in the past
+func (d *dnsLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to dnsLatency by design")
+	defer measurementWg.Done()
+}
+
+// Stop stops dnsLatency measurement
+func (d *dnsLatency) Stop() error {
+	return d.StopMeasurement(d.normalizeMetrics, d.getLatency)
+}
+
+// normalizeMetrics returns the percentage of failed lookups, they're indexed but excluded from the quantiles
+func (d *dnsLatency) normalizeMetrics() float64 {
+	var total, failed int
+	d.metrics.Range(func(key, value any) bool {
+		m := value.(dnsMetric)
+		total++
+		if !m.Resolved {
+			failed++
+		}
+		d.normLatencies = append(d.normLatencies, m)
+		return true
+	})
+	if total == 0 {
+		return 0
+	}
+	if failed > 0 {
+		log.Warnf("%s: %d out of %d DNS lookups of %s failed", d.JobConfig.Name, failed, total, d.target)
+	}
+	return float64(failed) / float64(total) * 100
+}
+
+func (d *dnsLatency) getLatency(normLatency any) map[string]float64 {
+	m := normLatency.(dnsMetric)
+	if !m.Resolved {
+		return nil
+	}
+	return map[string]float64{
+		dnsLookup: float64(m.DNSLatency),
+	}
+}
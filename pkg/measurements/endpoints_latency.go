@@ -0,0 +1,231 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	endpointsLatencyMeasurement          = "endpointsLatencyMeasurement"
+	endpointsLatencyQuantilesMeasurement = "endpointsLatencyQuantilesMeasurement"
+	endpointProgrammed                   = "EndpointProgrammed"
+)
+
+var (
+	supportedEndpointsConditions = map[string]struct{}{
+		endpointProgrammed: {},
+	}
+)
+
+// endpointMetric holds the programming latency of a pod endpoint in the EndpointSlices of a service
+type endpointMetric struct {
+	Timestamp       time.Time `json:"timestamp"`
+	programmed      int64
+	EndpointLatency int    `json:"endpointLatency"`
+	UUID            string `json:"uuid"`
+	JobName         string `json:"jobName,omitempty"`
+	MetricName      string `json:"metricName"`
+	Namespace       string `json:"namespace"`
+	Service         string `json:"service"`
+	podUID          string
+	PodName         string `json:"podName"`
+	PodIP           string `json:"podIP"`
+	JobIteration    int    `json:"jobIteration"`
+	Replica         int    `json:"replica"`
+	Metadata        any    `json:"metadata,omitempty"`
+}
+
+// podReadyTime holds the time a pod was observed as ready for the first time
+type podReadyTime struct {
+	ready        time.Time
+	jobIteration int
+	replica      int
+}
+
+type endpointsLatency struct {
+	BaseMeasurement
+	// podsReady pods ready time indexed by pod UID
+	podsReady sync.Map
+}
+
+type endpointsLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newEndpointsLatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedEndpointsConditions); err != nil {
+		return nil, err
+	}
+	return endpointsLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (elmf endpointsLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &endpointsLatency{
+		BaseMeasurement: elmf.NewBaseLatency(jobConfig, clientSet, restConfig, endpointsLatencyMeasurement, endpointsLatencyQuantilesMeasurement, embedCfg),
+	}
+}
+
+// handlePod records the first time a pod is observed as ready
+func (e *endpointsLatency) handlePod(obj any) {
+	pod := obj.(*corev1.Pod)
+	if _, exists := e.podsReady.Load(string(pod.UID)); exists {
+		return
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+			log.Tracef("Pod %s/%s is ready", pod.Namespace, pod.Name)
+			e.podsReady.LoadOrStore(string(pod.UID), podReadyTime{
+				ready:        time.Now().UTC(),
+				jobIteration: getIntFromLabels(pod.Labels, config.KubeBurnerLabelJobIteration),
+				replica:      getIntFromLabels(pod.Labels, config.KubeBurnerLabelReplica),
+			})
+			return
+		}
+	}
+}
+
+// handleEndpointSlice records the first time each pod endpoint is observed as ready in the EndpointSlices of a service
+func (e *endpointsLatency) handleEndpointSlice(obj any) {
+	endpointSlice := obj.(*discoveryv1.EndpointSlice)
+	service := endpointSlice.Labels[discoveryv1.LabelServiceName]
+	if service == "" {
+		return
+	}
+	now := time.Now().UTC().UnixMilli()
+	for _, endpoint := range endpointSlice.Endpoints {
+		if endpoint.TargetRef == nil || endpoint.TargetRef.Kind != "Pod" {
+			continue
+		}
+		if endpoint.Conditions.Ready == nil || !*endpoint.Conditions.Ready {
+			continue
+		}
+		var podIP string
+		if len(endpoint.Addresses) > 0 {
+			podIP = endpoint.Addresses[0]
+		}
+		key := fmt.Sprintf("%s/%s/%s", endpointSlice.Namespace, service, endpoint.TargetRef.UID)
+		if _, loaded := e.metrics.LoadOrStore(key, endpointMetric{
+			programmed: now,
+			UUID:       e.Uuid,
+			JobName:    e.JobConfig.Name,
+			MetricName: endpointsLatencyMeasurement,
+			Namespace:  endpointSlice.Namespace,
+			Service:    service,
+			podUID:     string(endpoint.TargetRef.UID),
+			PodName:    endpoint.TargetRef.Name,
+			PodIP:      podIP,
+			Metadata:   e.Metadata,
+		}); !loaded {
+			log.Tracef("Endpoint %s of service %s/%s programmed", podIP, endpointSlice.Namespace, service)
+		}
+	}
+}
+
+// start endpointsLatency measurement
+func (e *endpointsLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	if e.JobConfig.JobType != config.CreationJob {
+		log.Fatalf("Unsupported jobType:%s for endpointsLatency metric", e.JobConfig.JobType)
+	}
+	e.podsReady = sync.Map{}
+	e.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient:    e.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "podWatcher",
+				resource:      "pods",
+				labelSelector: e.objectSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: e.handlePod,
+					UpdateFunc: func(oldObj, newObj any) {
+						e.handlePod(newObj)
+					},
+				},
+			},
+			{
+				restClient:    e.ClientSet.DiscoveryV1().RESTClient().(*rest.RESTClient),
+				name:          "endpointSliceWatcher",
+				resource:      "endpointslices",
+				labelSelector: e.runSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: e.handleEndpointSlice,
+					UpdateFunc: func(oldObj, newObj any) {
+						e.handleEndpointSlice(newObj)
+					},
+				},
+			},
+		},
+	)
+	return nil
+}
+
+// collects endpoints measurements triggered in the past
+func (e *endpointsLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to endpointsLatency by design")
+	defer measurementWg.Done()
+}
+
+// stop endpoints latency measurement
+func (e *endpointsLatency) Stop() error {
+	return e.StopMeasurement(e.normalizeMetrics, e.getLatency)
+}
+
+// normalizeMetrics calculates the time from pod ready to its endpoint being programmed,
+// endpoints from pods not created by the benchmark are discarded
+func (e *endpointsLatency) normalizeMetrics() float64 {
+	e.metrics.Range(func(key, value any) bool {
+		m := value.(endpointMetric)
+		podValue, exists := e.podsReady.Load(m.podUID)
+		if !exists {
+			log.Tracef("Endpoint %v of service %v/%v ignored as its pod wasn't observed as ready", m.PodIP, m.Namespace, m.Service)
+			return true
+		}
+		pr := podValue.(podReadyTime)
+		m.Timestamp = pr.ready
+		m.JobIteration = pr.jobIteration
+		m.Replica = pr.replica
+		m.EndpointLatency = int(m.programmed - pr.ready.UnixMilli())
+		// Pod and EndpointSlice events are received by different watchers, so the endpoint can be observed first
+		if m.EndpointLatency < 0 {
+			log.Tracef("EndpointLatency for endpoint %v falling under negative case. So explicitly setting it to 0", m.PodIP)
+			m.EndpointLatency = 0
+		}
+		e.normLatencies = append(e.normLatencies, m)
+		return true
+	})
+	return 0
+}
+
+func (e *endpointsLatency) getLatency(normLatency any) map[string]float64 {
+	endpointMetric := normLatency.(endpointMetric)
+	return map[string]float64{
+		endpointProgrammed: float64(endpointMetric.EndpointLatency),
+	}
+}
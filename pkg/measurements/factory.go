@@ -15,13 +15,19 @@
 package measurements
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/cloud-bulldozer/go-commons/v2/indexers"
 	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/metrics"
 	"github.com/kube-burner/kube-burner/pkg/measurements/types"
 	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -50,16 +56,34 @@ type Measurement interface {
 }
 
 var measurementFactoryMap = map[string]NewMeasurementFactory{
-	"podLatency":            newPodLatencyMeasurementFactory,
-	"jobLatency":            newJobLatencyMeasurementFactory,
-	"pvcLatency":            newPvcLatencyMeasurementFactory,
-	"nodeLatency":           newNodeLatencyMeasurementFactory,
-	"vmiLatency":            newVmiLatencyMeasurementFactory,
-	"serviceLatency":        newServiceLatencyMeasurementFactory,
-	"pprof":                 newPprofLatencyMeasurementFactory,
-	"netpolLatency":         newNetpolLatencyMeasurementFactory,
-	"dataVolumeLatency":     newDvLatencyMeasurementFactory,
-	"volumeSnapshotLatency": newvolumeSnapshotLatencyMeasurementFactory,
+	"podLatency":               newPodLatencyMeasurementFactory,
+	"jobLatency":               newJobLatencyMeasurementFactory,
+	"pvcLatency":               newPvcLatencyMeasurementFactory,
+	"nodeLatency":              newNodeLatencyMeasurementFactory,
+	"vmiLatency":               newVmiLatencyMeasurementFactory,
+	"serviceLatency":           newServiceLatencyMeasurementFactory,
+	"pprof":                    newPprofLatencyMeasurementFactory,
+	"netpolLatency":            newNetpolLatencyMeasurementFactory,
+	"dataVolumeLatency":        newDvLatencyMeasurementFactory,
+	"volumeSnapshotLatency":    newvolumeSnapshotLatencyMeasurementFactory,
+	"containerRestarts":        newContainerRestartsMeasurementFactory,
+	"endpointsLatency":         newEndpointsLatencyMeasurementFactory,
+	"deletionLatency":          newDeletionLatencyMeasurementFactory,
+	"selfMetrics":              newSelfMetricsMeasurementFactory,
+	"readinessCheckLatency":    newReadinessCheckLatencyMeasurementFactory,
+	"configPropagationLatency": newConfigPropagationLatencyMeasurementFactory,
+	"webhookLatency":           newWebhookLatencyMeasurementFactory,
+	"firstLogLatency":          newFirstLogLatencyMeasurementFactory,
+	"nodeFlaps":                newNodeFlapsMeasurementFactory,
+	"logErrors":                newLogErrorsMeasurementFactory,
+	"hpaLatency":               newHPALatencyMeasurementFactory,
+	"imagePullLatency":         newImagePullLatencyMeasurementFactory,
+	"ingressLatency":           newIngressLatencyMeasurementFactory,
+	"leaderElection":           newLeaderElectionMeasurementFactory,
+	"pdbEvictionLatency":       newPDBEvictionLatencyMeasurementFactory,
+	"statefulSetLatency":       newStatefulSetLatencyMeasurementFactory,
+	"dnsLatency":               newDNSLatencyMeasurementFactory,
+	"reconciliationLatency":    newReconciliationLatencyMeasurementFactory,
 }
 
 func isIndexerOk(configSpec config.Spec, measurement types.Measurement) bool {
@@ -74,6 +98,35 @@ func isIndexerOk(configSpec config.Spec, measurement types.Measurement) bool {
 	return true
 }
 
+// SelectMeasurements restricts the configured measurements to the given names, the names not configured are added with their
+// default configuration. An error listing the supported measurements is returned when any of the names isn't supported
+func SelectMeasurements(configured []types.Measurement, names []string, additionalMeasurementFactoryMap map[string]NewMeasurementFactory) ([]types.Measurement, error) {
+	supported := slices.Sorted(maps.Keys(measurementFactoryMap))
+	for name := range additionalMeasurementFactoryMap {
+		if !slices.Contains(supported, name) {
+			supported = append(supported, name)
+		}
+	}
+	slices.Sort(supported)
+	selected := []types.Measurement{}
+	for _, name := range names {
+		if !slices.Contains(supported, name) {
+			return nil, fmt.Errorf("unknown measurement %s, supported measurements are: %s", name, strings.Join(supported, ", "))
+		}
+		var found bool
+		for _, measurement := range configured {
+			if measurement.Name == name {
+				selected = append(selected, measurement)
+				found = true
+			}
+		}
+		if !found {
+			selected = append(selected, types.Measurement{Name: name})
+		}
+	}
+	return selected, nil
+}
+
 // NewMeasurementsFactory initializes the measurement facture
 func NewMeasurementsFactory(configSpec config.Spec, metadata map[string]any, additionalMeasurementFactoryMap map[string]NewMeasurementFactory) *MeasurementsFactory {
 	// Add from additionalMeasurementFactoryMap without overwriting
@@ -95,6 +148,22 @@ func NewMeasurementsFactory(configSpec config.Spec, metadata map[string]any, add
 			log.Warnf("Measurement [%s] is registered more than once", measurement.Name)
 			continue
 		}
+		for _, quantile := range measurement.Quantiles {
+			if quantile <= 0 || quantile >= 1 {
+				log.Fatalf("Invalid quantile %v in measurement %s, quantiles must be between 0 and 1", quantile, measurement.Name)
+			}
+		}
+		if rs := measurement.RawSamples; rs != nil {
+			if rs.SampleRate < 0 || rs.SampleRate > 1 {
+				log.Fatalf("Invalid rawSamples sampleRate %v in measurement %s, it must be between 0 and 1", rs.SampleRate, measurement.Name)
+			}
+			if rs.MaxSamples < 0 {
+				log.Fatalf("Invalid rawSamples maxSamples %d in measurement %s, it must be >= 0", rs.MaxSamples, measurement.Name)
+			}
+		}
+		if _, err := labels.Parse(measurement.LabelSelector); err != nil {
+			log.Fatalf("Invalid labelSelector in measurement %s: %v", measurement.Name, err)
+		}
 		newMeasurementFactoryFunc, exists := measurementFactoryMap[measurement.Name]
 		if !exists {
 			log.Warnf("Measurement [%s] is not supported", measurement.Name)
@@ -114,7 +183,7 @@ func (msf *MeasurementsFactory) NewMeasurements(jobConfig *config.Job, kubeClien
 	ms := Measurements{
 		MeasurementsMap: make(map[string]Measurement, len(msf.Factories)),
 	}
-	clientSet, restConfig := kubeClientProvider.ClientSet(jobConfig.QPS, jobConfig.Burst)
+	clientSet, restConfig := kubeClientProvider.JobClientSet(*jobConfig)
 	for name, factory := range msf.Factories {
 		ms.MeasurementsMap[name] = factory.NewMeasurement(jobConfig, clientSet, restConfig, embedCfg)
 	}
@@ -163,6 +232,28 @@ func (ms *Measurements) Index(jobName string, indexerList map[string]indexers.In
 	}
 }
 
+// quantilesMeasurement is implemented by the measurements calculating latency quantiles, like the ones embedding BaseMeasurement
+type quantilesMeasurement interface {
+	GetQuantiles() []any
+}
+
+// GetQuantiles returns the latency quantiles of the measurements, sorted by measurement name
+func (ms *Measurements) GetQuantiles() []metrics.LatencyQuantiles {
+	var quantiles []metrics.LatencyQuantiles
+	for _, name := range slices.Sorted(maps.Keys(ms.MeasurementsMap)) {
+		qm, ok := ms.MeasurementsMap[name].(quantilesMeasurement)
+		if !ok {
+			continue
+		}
+		for _, q := range qm.GetQuantiles() {
+			if lq, ok := q.(metrics.LatencyQuantiles); ok {
+				quantiles = append(quantiles, lq)
+			}
+		}
+	}
+	return quantiles
+}
+
 func (ms *Measurements) GetMetrics() []*sync.Map {
 	var metricList []*sync.Map
 	for name, measurement := range ms.MeasurementsMap {
@@ -0,0 +1,277 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	firstLogLatencyMeasurement          = "firstLogLatencyMeasurement"
+	firstLogLatencyQuantilesMeasurement = "firstLogLatencyQuantilesMeasurement"
+	firstLogCondition                   = "FirstLog"
+	defaultFirstLogMaxStreams           = 50
+	// maxLogLineSize log lines longer than this are not matched
+	maxLogLineSize = 1024 * 1024
+)
+
+var (
+	supportedFirstLogConditions = map[string]struct{}{
+		firstLogCondition: {},
+	}
+)
+
+// firstLogMetric holds the time elapsed from the pod creation until its first log line matching the pattern
+type firstLogMetric struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Matched whether a log line matched the pattern before the end of the job
+	Matched         bool   `json:"matched"`
+	FirstLogLatency int    `json:"firstLogLatency"`
+	UUID            string `json:"uuid"`
+	JobName         string `json:"jobName,omitempty"`
+	MetricName      string `json:"metricName"`
+	Namespace       string `json:"namespace"`
+	PodName         string `json:"podName"`
+	Container       string `json:"container"`
+	NodeName        string `json:"nodeName"`
+	JobIteration    int    `json:"jobIteration"`
+	Replica         int    `json:"replica"`
+	Metadata        any    `json:"metadata,omitempty"`
+}
+
+// firstLogPod holds the first matching log line timestamp of a pod, zero while no line matched
+type firstLogPod struct {
+	firstLogMetric
+	firstLog time.Time
+}
+
+type firstLogLatency struct {
+	BaseMeasurement
+	pattern *regexp.Regexp
+	mu      sync.Mutex
+	// pods pods whose logs are being read, indexed by pod UID
+	pods map[string]*firstLogPod
+	// streams limits the number of log streams open at the same time
+	streams  chan struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
+	streamWg sync.WaitGroup
+}
+
+type firstLogLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+	pattern *regexp.Regexp
+}
+
+func newFirstLogLatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedFirstLogConditions); err != nil {
+		return nil, err
+	}
+	pattern, err := regexp.Compile(measurement.FirstLog.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid firstLog pattern: %v", err)
+	}
+	if measurement.FirstLog.MaxStreams < 0 {
+		return nil, fmt.Errorf("firstLog maxStreams must be >= 0")
+	}
+	if measurement.FirstLog.MaxStreams == 0 {
+		measurement.FirstLog.MaxStreams = defaultFirstLogMaxStreams
+	}
+	return firstLogLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+		pattern:                pattern,
+	}, nil
+}
+
+func (fllmf firstLogLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &firstLogLatency{
+		BaseMeasurement: fllmf.NewBaseLatency(jobConfig, clientSet, restConfig, firstLogLatencyMeasurement, firstLogLatencyQuantilesMeasurement, embedCfg),
+		pattern:         fllmf.pattern,
+	}
+}
+
+// handlePod starts reading the logs of the pod once its container is started
+func (f *firstLogLatency) handlePod(obj any) {
+	pod := obj.(*corev1.Pod)
+	container := f.Config.FirstLog.Container
+	if container == "" && len(pod.Spec.Containers) > 0 {
+		container = pod.Spec.Containers[0].Name
+	}
+	started := false
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == container && (cs.State.Running != nil || cs.State.Terminated != nil) {
+			started = true
+		}
+	}
+	if !started {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	// No new streams are started once the measurement is stopped
+	if _, exists := f.pods[string(pod.UID)]; exists || f.ctx.Err() != nil {
+		return
+	}
+	p := &firstLogPod{
+		firstLogMetric: firstLogMetric{
+			Timestamp:    pod.CreationTimestamp.UTC(),
+			UUID:         f.Uuid,
+			JobName:      f.JobConfig.Name,
+			MetricName:   firstLogLatencyMeasurement,
+			Namespace:    pod.Namespace,
+			PodName:      pod.Name,
+			Container:    container,
+			NodeName:     pod.Spec.NodeName,
+			JobIteration: getIntFromLabels(pod.Labels, config.KubeBurnerLabelJobIteration),
+			Replica:      getIntFromLabels(pod.Labels, config.KubeBurnerLabelReplica),
+			Metadata:     f.Metadata,
+		},
+	}
+	f.pods[string(pod.UID)] = p
+	f.streamWg.Add(1)
+	go f.readFirstLog(p)
+}
+
+// readFirstLog follows the logs of the pod container until a line matches the pattern. The log lines are
+// requested with the timestamps recorded by the kubelet, so the time spent waiting for a stream slot doesn't skew the latency
+func (f *firstLogLatency) readFirstLog(p *firstLogPod) {
+	defer f.streamWg.Done()
+	select {
+	case f.streams <- struct{}{}:
+		defer func() { <-f.streams }()
+	case <-f.ctx.Done():
+		return
+	}
+	stream, err := f.ClientSet.CoreV1().Pods(p.Namespace).GetLogs(p.PodName, &corev1.PodLogOptions{
+		Container:  p.Container,
+		Follow:     true,
+		Timestamps: true,
+	}).Stream(f.ctx)
+	if err != nil {
+		if f.ctx.Err() == nil {
+			log.Debugf("Error reading logs of pod %s/%s: %v", p.Namespace, p.PodName, err)
+		}
+		return
+	}
+	defer stream.Close()
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+	for scanner.Scan() {
+		timestamp, line, found := strings.Cut(scanner.Text(), " ")
+		if !found || !f.pattern.MatchString(line) {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, timestamp)
+		if err != nil {
+			log.Debugf("Error parsing log timestamp of pod %s/%s: %v", p.Namespace, p.PodName, err)
+			continue
+		}
+		log.Tracef("First log line of pod %s/%s at %v", p.Namespace, p.PodName, t)
+		f.mu.Lock()
+		p.firstLog = t.UTC()
+		f.mu.Unlock()
+		return
+	}
+}
+
+// start firstLogLatency measurement
+func (f *firstLogLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	f.pods = map[string]*firstLogPod{}
+	f.streams = make(chan struct{}, f.Config.FirstLog.MaxStreams)
+	f.ctx, f.cancel = context.WithCancel(context.Background())
+	f.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient:    f.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "podWatcher",
+				resource:      "pods",
+				labelSelector: f.objectSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: f.handlePod,
+					UpdateFunc: func(oldObj, newObj any) {
+						f.handlePod(newObj)
+					},
+				},
+			},
+		},
+	)
+	return nil
+}
+
+// collects firstLogLatency measurements triggered in the past
+func (f *firstLogLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to firstLogLatency by design")
+	defer measurementWg.Done()
+}
+
+// stop firstLogLatency measurement, the log streams still open are closed
+func (f *firstLogLatency) Stop() error {
+	f.mu.Lock()
+	f.cancel()
+	f.mu.Unlock()
+	f.streamWg.Wait()
+	return f.StopMeasurement(f.normalizeMetrics, f.getLatency)
+}
+
+// normalizeMetrics calculates the first log latency of each pod, returns the percentage of pods without a matching log line
+func (f *firstLogLatency) normalizeMetrics() float64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var notMatched int
+	for _, p := range f.pods {
+		m := p.firstLogMetric
+		if !p.firstLog.IsZero() {
+			m.Matched = true
+			m.FirstLogLatency = int(p.firstLog.Sub(m.Timestamp).Milliseconds())
+		} else {
+			notMatched++
+			log.Debugf("No log line of pod %s/%s matched the pattern", m.Namespace, m.PodName)
+		}
+		f.normLatencies = append(f.normLatencies, m)
+	}
+	if len(f.pods) == 0 {
+		return 0
+	}
+	if notMatched > 0 {
+		log.Errorf("%d out of %d pods didn't log a line matching the pattern", notMatched, len(f.pods))
+	}
+	return float64(notMatched) / float64(len(f.pods)) * 100
+}
+
+func (f *firstLogLatency) getLatency(normLatency any) map[string]float64 {
+	m := normLatency.(firstLogMetric)
+	if !m.Matched {
+		return map[string]float64{}
+	}
+	return map[string]float64{
+		firstLogCondition: float64(m.FirstLogLatency),
+	}
+}
@@ -0,0 +1,332 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	hpaLatencyMeasurement          = "hpaLatencyMeasurement"
+	hpaLatencyQuantilesMeasurement = "hpaLatencyQuantilesMeasurement"
+	hpaScaleUp                     = "ScaleUp"
+	hpaScaleDown                   = "ScaleDown"
+	deploymentKind                 = "Deployment"
+	statefulSetKind                = "StatefulSet"
+)
+
+var (
+	supportedHPAConditions = map[string]struct{}{
+		hpaScaleUp:   {},
+		hpaScaleDown: {},
+	}
+)
+
+// hpaMetric holds the latency of a scaling decision of a HorizontalPodAutoscaler, from the change of its desired replicas
+// until its target reaches them
+type hpaMetric struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Completed whether the target reached the desired replicas before the end of the job
+	Completed bool `json:"completed"`
+	// Superseded whether a new scaling decision was taken before the target reached the desired replicas
+	Superseded     bool   `json:"superseded,omitempty"`
+	ScalingLatency int    `json:"scalingLatency"`
+	Direction      string `json:"direction"`
+	FromReplicas   int32  `json:"fromReplicas"`
+	ToReplicas     int32  `json:"toReplicas"`
+	UUID           string `json:"uuid"`
+	JobName        string `json:"jobName,omitempty"`
+	MetricName     string `json:"metricName"`
+	Namespace      string `json:"namespace"`
+	HPAName        string `json:"hpaName"`
+	TargetKind     string `json:"targetKind"`
+	TargetName     string `json:"targetName"`
+	Metadata       any    `json:"metadata,omitempty"`
+}
+
+// hpaTarget identifies the workload scaled by a HorizontalPodAutoscaler
+type hpaTarget struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// hpaState holds the last desired replicas of a HorizontalPodAutoscaler and its ongoing scaling decision
+type hpaState struct {
+	name            string
+	target          hpaTarget
+	desiredReplicas int32
+	scaling         *hpaMetric
+}
+
+// hpaTargetReplicas holds the replicas reported by a target workload
+type hpaTargetReplicas struct {
+	replicas      int32
+	readyReplicas int32
+}
+
+type hpaLatency struct {
+	BaseMeasurement
+	mu sync.Mutex
+	// hpas tracked HorizontalPodAutoscalers indexed by their target
+	hpas map[hpaTarget]*hpaState
+	// targets last replicas reported by each target workload
+	targets map[hpaTarget]hpaTargetReplicas
+}
+
+type hpaLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newHPALatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedHPAConditions); err != nil {
+		return nil, err
+	}
+	if measurement.HPA.Name != "" && measurement.HPA.Namespace == "" {
+		return nil, fmt.Errorf("hpa namespace is required when its name is set")
+	}
+	return hpaLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (hlmf hpaLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &hpaLatency{
+		BaseMeasurement: hlmf.NewBaseLatency(jobConfig, clientSet, restConfig, hpaLatencyMeasurement, hpaLatencyQuantilesMeasurement, embedCfg),
+	}
+}
+
+// handleHPA starts tracking a scaling decision each time the desired replicas of the HorizontalPodAutoscaler change,
+// a scaling decision not completed when the next one is taken is flagged as superseded
+func (h *hpaLatency) handleHPA(obj any) {
+	hpa := obj.(*autoscalingv2.HorizontalPodAutoscaler)
+	if h.Config.HPA.Name != "" && hpa.Name != h.Config.HPA.Name {
+		return
+	}
+	target := hpaTarget{hpa.Spec.ScaleTargetRef.Kind, hpa.Namespace, hpa.Spec.ScaleTargetRef.Name}
+	if target.kind != deploymentKind && target.kind != statefulSetKind {
+		log.Debugf("HorizontalPodAutoscaler %s/%s target kind %s not supported, only %s and %s targets are tracked", hpa.Namespace, hpa.Name, target.kind, deploymentKind, statefulSetKind)
+		return
+	}
+	desiredReplicas := hpa.Status.DesiredReplicas
+	now := time.Now().UTC()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	state, exists := h.hpas[target]
+	if !exists {
+		h.hpas[target] = &hpaState{name: hpa.Name, target: target, desiredReplicas: desiredReplicas}
+		return
+	}
+	if desiredReplicas == state.desiredReplicas || desiredReplicas == 0 {
+		return
+	}
+	if state.scaling != nil {
+		log.Debugf("HorizontalPodAutoscaler %s/%s scaled to %d replicas before reaching %d", hpa.Namespace, hpa.Name, desiredReplicas, state.scaling.ToReplicas)
+		state.scaling.Superseded = true
+		h.normLatencies = append(h.normLatencies, *state.scaling)
+	}
+	direction := hpaScaleUp
+	if desiredReplicas < state.desiredReplicas {
+		direction = hpaScaleDown
+	}
+	log.Tracef("HorizontalPodAutoscaler %s/%s desired replicas changed from %d to %d", hpa.Namespace, hpa.Name, state.desiredReplicas, desiredReplicas)
+	state.scaling = &hpaMetric{
+		Timestamp:    now,
+		Direction:    direction,
+		FromReplicas: state.desiredReplicas,
+		ToReplicas:   desiredReplicas,
+		UUID:         h.Uuid,
+		JobName:      h.JobConfig.Name,
+		MetricName:   hpaLatencyMeasurement,
+		Namespace:    hpa.Namespace,
+		HPAName:      hpa.Name,
+		TargetKind:   target.kind,
+		TargetName:   target.name,
+		Metadata:     h.Metadata,
+	}
+	state.desiredReplicas = desiredReplicas
+	h.checkScaling(state, now)
+}
+
+// handleTarget records the replicas of the target workloads
+func (h *hpaLatency) handleTarget(obj any) {
+	var target hpaTarget
+	var replicas hpaTargetReplicas
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		target = hpaTarget{deploymentKind, o.Namespace, o.Name}
+		replicas = hpaTargetReplicas{o.Status.Replicas, o.Status.ReadyReplicas}
+	case *appsv1.StatefulSet:
+		target = hpaTarget{statefulSetKind, o.Namespace, o.Name}
+		replicas = hpaTargetReplicas{o.Status.Replicas, o.Status.ReadyReplicas}
+	default:
+		return
+	}
+	now := time.Now().UTC()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.targets[target] = replicas
+	if state, exists := h.hpas[target]; exists {
+		h.checkScaling(state, now)
+	}
+}
+
+// checkScaling completes the ongoing scaling decision once the target reaches the desired replicas: all of them ready when
+// scaling up, and no replicas beyond them when scaling down
+func (h *hpaLatency) checkScaling(state *hpaState, now time.Time) {
+	if state.scaling == nil {
+		return
+	}
+	replicas, exists := h.targets[state.target]
+	if !exists {
+		return
+	}
+	switch state.scaling.Direction {
+	case hpaScaleUp:
+		if replicas.readyReplicas < state.scaling.ToReplicas {
+			return
+		}
+	case hpaScaleDown:
+		if replicas.replicas > state.scaling.ToReplicas || replicas.readyReplicas > state.scaling.ToReplicas {
+			return
+		}
+	}
+	state.scaling.Completed = true
+	state.scaling.ScalingLatency = int(now.Sub(state.scaling.Timestamp).Milliseconds())
+	log.Debugf("%s %s/%s scaled from %d to %d replicas in %dms", state.target.kind, state.target.namespace, state.target.name, state.scaling.FromReplicas, state.scaling.ToReplicas, state.scaling.ScalingLatency)
+	h.normLatencies = append(h.normLatencies, *state.scaling)
+	state.scaling = nil
+}
+
+// start hpaLatency measurement
+func (h *hpaLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	h.hpas = map[hpaTarget]*hpaState{}
+	h.targets = map[hpaTarget]hpaTargetReplicas{}
+	// The HorizontalPodAutoscaler given by name and its target aren't necessarily created by the benchmark
+	var labelSelector, targetSelector string
+	if h.Config.HPA.Name == "" {
+		labelSelector, targetSelector = h.objectSelector(), h.runSelector()
+	}
+	targetHandlers := &cache.ResourceEventHandlerFuncs{
+		AddFunc: h.handleTarget,
+		UpdateFunc: func(oldObj, newObj any) {
+			h.handleTarget(newObj)
+		},
+	}
+	h.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient:    h.ClientSet.AutoscalingV2().RESTClient().(*rest.RESTClient),
+				name:          "hpaWatcher",
+				resource:      "horizontalpodautoscalers",
+				namespace:     h.Config.HPA.Namespace,
+				labelSelector: labelSelector,
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: h.handleHPA,
+					UpdateFunc: func(oldObj, newObj any) {
+						h.handleHPA(newObj)
+					},
+				},
+			},
+			{
+				restClient:    h.ClientSet.AppsV1().RESTClient().(*rest.RESTClient),
+				name:          "deploymentWatcher",
+				resource:      "deployments",
+				namespace:     h.Config.HPA.Namespace,
+				labelSelector: targetSelector,
+				handlers:      targetHandlers,
+			},
+			{
+				restClient:    h.ClientSet.AppsV1().RESTClient().(*rest.RESTClient),
+				name:          "statefulSetWatcher",
+				resource:      "statefulsets",
+				namespace:     h.Config.HPA.Namespace,
+				labelSelector: targetSelector,
+				handlers:      targetHandlers,
+			},
+		},
+	)
+	return nil
+}
+
+// collects hpaLatency measurements triggered in the past
+func (h *hpaLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to hpaLatency by design")
+	defer measurementWg.Done()
+}
+
+// stop hpaLatency measurement
+func (h *hpaLatency) Stop() error {
+	return h.StopMeasurement(h.normalizeMetrics, h.getLatency)
+}
+
+// normalizeMetrics accounts the ongoing scaling decisions as not completed, and returns the percentage of scaling
+// decisions not completed. Superseded scaling decisions aren't accounted
+func (h *hpaLatency) normalizeMetrics() float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, state := range h.hpas {
+		if state.scaling != nil {
+			log.Debugf("%s %s/%s didn't reach %d replicas before the end of the job", state.target.kind, state.target.namespace, state.target.name, state.scaling.ToReplicas)
+			h.normLatencies = append(h.normLatencies, *state.scaling)
+			state.scaling = nil
+		}
+	}
+	if len(h.normLatencies) == 0 {
+		log.Warnf("%s: no scaling decisions observed", h.JobConfig.Name)
+		return 0
+	}
+	var total, notCompleted int
+	for _, normLatency := range h.normLatencies {
+		m := normLatency.(hpaMetric)
+		if m.Superseded {
+			continue
+		}
+		total++
+		if !m.Completed {
+			notCompleted++
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	if notCompleted > 0 {
+		log.Errorf("%d out of %d scaling decisions weren't completed", notCompleted, total)
+	}
+	return float64(notCompleted) / float64(total) * 100
+}
+
+func (h *hpaLatency) getLatency(normLatency any) map[string]float64 {
+	m := normLatency.(hpaMetric)
+	if !m.Completed {
+		return map[string]float64{}
+	}
+	return map[string]float64{
+		m.Direction: float64(m.ScalingLatency),
+	}
+}
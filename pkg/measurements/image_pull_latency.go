@@ -0,0 +1,300 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	imagePullLatencyMeasurement          = "imagePullLatencyMeasurement"
+	imagePullLatencyQuantilesMeasurement = "imagePullLatencyQuantilesMeasurement"
+	imagePulled                          = "ImagePull"
+	pullingReason                        = "Pulling"
+	pulledReason                         = "Pulled"
+)
+
+var (
+	supportedImagePullConditions = map[string]struct{}{
+		imagePulled: {},
+	}
+	// Messages of the Pulling and Pulled events recorded by the kubelet
+	pullingImageRegex = regexp.MustCompile(`^Pulling image "(.+)"`)
+	pulledImageRegex  = regexp.MustCompile(`^Successfully pulled image "(.+)" in ([^ ]+)(?: \(([^ ]+) including waiting\))?`)
+	presentImageRegex = regexp.MustCompile(`^Container image "(.+)" already present on machine`)
+	// containerFieldPathRegex extracts the container name from the field path of the event involved object
+	containerFieldPathRegex = regexp.MustCompile(`^spec\.(?:initContainers|containers|ephemeralContainers)\{(.+)\}$`)
+)
+
+// imagePullMetric holds the pull duration of the image of a pod container
+type imagePullMetric struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Cached whether the image was already present on the node, it's not accounted in the quantiles
+	Cached bool `json:"cached"`
+	// PullLatency pull duration in milliseconds, and including the time waiting for other pulls in PullLatencyWaiting
+	PullLatency        int    `json:"pullLatency"`
+	PullLatencyWaiting int    `json:"pullLatencyWaiting,omitempty"`
+	Image              string `json:"image"`
+	UUID               string `json:"uuid"`
+	JobName            string `json:"jobName,omitempty"`
+	MetricName         string `json:"metricName"`
+	Namespace          string `json:"namespace"`
+	PodName            string `json:"podName"`
+	Container          string `json:"container"`
+	NodeName           string `json:"nodeName"`
+	JobIteration       int    `json:"jobIteration"`
+	Replica            int    `json:"replica"`
+	Metadata           any    `json:"metadata,omitempty"`
+}
+
+// imagePullPod holds the job pods
+type imagePullPod struct {
+	name         string
+	nodeName     string
+	jobIteration int
+	replica      int
+}
+
+// imagePullKey identifies the image pull of a pod container
+type imagePullKey struct {
+	podUID    string
+	container string
+}
+
+// imagePull holds the Pulling and Pulled events of a pod container
+type imagePull struct {
+	namespace string
+	image     string
+	host      string
+	pulling   time.Time
+	pulled    *imagePullMetric
+}
+
+type imagePullLatency struct {
+	BaseMeasurement
+	mu sync.Mutex
+	// pods job pods indexed by UID
+	pods map[string]*imagePullPod
+	// pulls image pulls of every pod, events can be received before their pod
+	pulls map[imagePullKey]*imagePull
+}
+
+type imagePullLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newImagePullLatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedImagePullConditions); err != nil {
+		return nil, err
+	}
+	return imagePullLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (iplmf imagePullLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &imagePullLatency{
+		BaseMeasurement: iplmf.NewBaseLatency(jobConfig, clientSet, restConfig, imagePullLatencyMeasurement, imagePullLatencyQuantilesMeasurement, embedCfg),
+	}
+}
+
+// handlePod records the job pods and the node where they're scheduled
+func (i *imagePullLatency) handlePod(obj any) {
+	pod := obj.(*corev1.Pod)
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if p, exists := i.pods[string(pod.UID)]; exists {
+		p.nodeName = pod.Spec.NodeName
+		return
+	}
+	i.pods[string(pod.UID)] = &imagePullPod{
+		name:         pod.Name,
+		nodeName:     pod.Spec.NodeName,
+		jobIteration: getIntFromLabels(pod.Labels, config.KubeBurnerLabelJobIteration),
+		replica:      getIntFromLabels(pod.Labels, config.KubeBurnerLabelReplica),
+	}
+}
+
+// eventTime returns the time the event was last observed
+func eventTime(event *corev1.Event) time.Time {
+	if !event.EventTime.IsZero() {
+		return event.EventTime.UTC()
+	}
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.UTC()
+	}
+	return event.CreationTimestamp.UTC()
+}
+
+// handleEvent records the Pulling and Pulled events of the pod containers. The pull duration is taken from the Pulled
+// event message, or calculated from the events timestamps when the message doesn't report it
+func (i *imagePullLatency) handleEvent(obj any) {
+	event := obj.(*corev1.Event)
+	if event.Reason != pullingReason && event.Reason != pulledReason {
+		return
+	}
+	container := event.InvolvedObject.FieldPath
+	if match := containerFieldPathRegex.FindStringSubmatch(container); match != nil {
+		container = match[1]
+	}
+	key := imagePullKey{string(event.InvolvedObject.UID), container}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	pull, exists := i.pulls[key]
+	if !exists {
+		pull = &imagePull{namespace: event.InvolvedObject.Namespace, host: event.Source.Host}
+		i.pulls[key] = pull
+	}
+	// Only the first pull of each container is measured, restarts reuse the image
+	if pull.pulled != nil {
+		return
+	}
+	timestamp := eventTime(event)
+	if event.Reason == pullingReason {
+		if match := pullingImageRegex.FindStringSubmatch(event.Message); match != nil && pull.pulling.IsZero() {
+			pull.image = match[1]
+			pull.pulling = timestamp
+		}
+		return
+	}
+	m := &imagePullMetric{Timestamp: timestamp, Image: pull.image}
+	if match := presentImageRegex.FindStringSubmatch(event.Message); match != nil {
+		m.Cached = true
+		m.Image = match[1]
+	} else if match := pulledImageRegex.FindStringSubmatch(event.Message); match != nil {
+		m.Image = match[1]
+		if pullDuration, err := time.ParseDuration(match[2]); err == nil {
+			m.PullLatency = int(pullDuration.Milliseconds())
+		} else if !pull.pulling.IsZero() {
+			m.PullLatency = int(timestamp.Sub(pull.pulling).Milliseconds())
+		}
+		if match[3] != "" {
+			if waitingDuration, err := time.ParseDuration(match[3]); err == nil {
+				m.PullLatencyWaiting = int(waitingDuration.Milliseconds())
+			}
+		}
+		if !pull.pulling.IsZero() {
+			m.Timestamp = pull.pulling
+		}
+	} else {
+		log.Debugf("Unexpected %s event message of pod %s/%s: %s", event.Reason, event.InvolvedObject.Namespace, event.InvolvedObject.Name, event.Message)
+		return
+	}
+	pull.pulled = m
+}
+
+// start imagePullLatency measurement
+func (i *imagePullLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	i.pods = map[string]*imagePullPod{}
+	i.pulls = map[imagePullKey]*imagePull{}
+	i.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient:    i.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "podWatcher",
+				resource:      "pods",
+				labelSelector: i.objectSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: i.handlePod,
+					UpdateFunc: func(oldObj, newObj any) {
+						i.handlePod(newObj)
+					},
+				},
+			},
+			{
+				restClient:    i.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "eventWatcher",
+				resource:      "events",
+				fieldSelector: "involvedObject.kind=Pod",
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: i.handleEvent,
+					UpdateFunc: func(oldObj, newObj any) {
+						i.handleEvent(newObj)
+					},
+				},
+			},
+		},
+	)
+	return nil
+}
+
+// collects imagePullLatency measurements triggered in the past
+func (i *imagePullLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to imagePullLatency by design")
+	defer measurementWg.Done()
+}
+
+// stop imagePullLatency measurement
+func (i *imagePullLatency) Stop() error {
+	return i.StopMeasurement(i.normalizeMetrics, i.getLatency)
+}
+
+// normalizeMetrics generates a document for each image pull of the job pods. Pulls without a Pulled event, like the
+// ones which failed, aren't accounted
+func (i *imagePullLatency) normalizeMetrics() float64 {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	var pulled, cached int
+	for key, pull := range i.pulls {
+		p, exists := i.pods[key.podUID]
+		if !exists || pull.pulled == nil {
+			continue
+		}
+		m := *pull.pulled
+		m.UUID = i.Uuid
+		m.JobName = i.JobConfig.Name
+		m.MetricName = imagePullLatencyMeasurement
+		m.Namespace = pull.namespace
+		m.PodName = p.name
+		m.Container = key.container
+		m.NodeName = p.nodeName
+		if m.NodeName == "" {
+			m.NodeName = pull.host
+		}
+		m.JobIteration = p.jobIteration
+		m.Replica = p.replica
+		m.Metadata = i.Metadata
+		if m.Cached {
+			cached++
+		} else {
+			pulled++
+		}
+		i.normLatencies = append(i.normLatencies, m)
+	}
+	log.Infof("%s: %d images pulled, %d images already present on the nodes", i.JobConfig.Name, pulled, cached)
+	return 0
+}
+
+func (i *imagePullLatency) getLatency(normLatency any) map[string]float64 {
+	m := normLatency.(imagePullMetric)
+	if m.Cached {
+		return map[string]float64{}
+	}
+	return map[string]float64{
+		imagePulled: float64(m.PullLatency),
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	ingressLatencyMeasurement          = "ingressLatencyMeasurement"
+	ingressLatencyQuantilesMeasurement = "ingressLatencyQuantilesMeasurement"
+	ingressReady                       = "Ready"
+	ingressServing                     = "Serving"
+	ingressKind                        = "Ingress"
+	httpRouteKind                      = "HTTPRoute"
+	defaultIngressProbeTimeout         = 5 * time.Minute
+	ingressProbeInterval               = time.Second
+)
+
+var (
+	supportedIngressConditions = map[string]struct{}{
+		ingressReady:   {},
+		ingressServing: {},
+	}
+	gatewayGroupVersion = schema.GroupVersion{Group: "gateway.networking.k8s.io", Version: "v1"}
+	httpRouteGVR        = gatewayGroupVersion.WithResource("httproutes")
+	gatewayGVR          = gatewayGroupVersion.WithResource("gateways")
+)
+
+// ingressMetric holds the latency of an Ingress or HTTPRoute, from its creation until it's ready and served
+type ingressMetric struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Ready whether the address of the Ingress was populated, or the HTTPRoute was accepted by all its parents
+	Ready          bool   `json:"ready"`
+	ReadyLatency   int    `json:"readyLatency"`
+	Serving        bool   `json:"serving,omitempty"`
+	ServingLatency int    `json:"servingLatency,omitempty"`
+	Address        string `json:"address,omitempty"`
+	UUID           string `json:"uuid"`
+	JobName        string `json:"jobName,omitempty"`
+	MetricName     string `json:"metricName"`
+	Kind           string `json:"kind"`
+	Namespace      string `json:"namespace"`
+	Name           string `json:"name"`
+	JobIteration   int    `json:"jobIteration"`
+	Replica        int    `json:"replica"`
+	Metadata       any    `json:"metadata,omitempty"`
+}
+
+// ingressProbe holds the request sent to probe a ready Ingress or HTTPRoute, the address of an HTTPRoute is the one of its parent Gateway
+type ingressProbe struct {
+	address string
+	host    string
+	path    string
+	gateway *ktypes.NamespacedName
+}
+
+type ingressLatency struct {
+	BaseMeasurement
+	mu sync.Mutex
+	// objects Ingresses and HTTPRoutes indexed by UID
+	objects       map[string]*ingressMetric
+	dynamicClient dynamic.Interface
+	httpClient    *http.Client
+	ctx           context.Context
+	cancel        context.CancelFunc
+	probeWg       sync.WaitGroup
+	stopCh        chan struct{}
+}
+
+type ingressLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newIngressLatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedIngressConditions); err != nil {
+		return nil, err
+	}
+	if measurement.Ingress.ProbeStatus == 0 {
+		measurement.Ingress.ProbeStatus = http.StatusOK
+	}
+	if measurement.Ingress.ProbeTimeout == 0 {
+		measurement.Ingress.ProbeTimeout = defaultIngressProbeTimeout
+	}
+	if measurement.Ingress.ProbeTimeout < 0 {
+		return nil, fmt.Errorf("ingress probeTimeout must be >= 0")
+	}
+	return ingressLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (ilmf ingressLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &ingressLatency{
+		BaseMeasurement: ilmf.NewBaseLatency(jobConfig, clientSet, restConfig, ingressLatencyMeasurement, ingressLatencyQuantilesMeasurement, embedCfg),
+	}
+}
+
+// trackObject returns the metric of the given object, created on its first event
+func (i *ingressLatency) trackObject(kind string, meta metav1.Object) *ingressMetric {
+	m, exists := i.objects[string(meta.GetUID())]
+	if !exists {
+		m = &ingressMetric{
+			Timestamp:    meta.GetCreationTimestamp().UTC(),
+			UUID:         i.Uuid,
+			JobName:      i.JobConfig.Name,
+			MetricName:   ingressLatencyMeasurement,
+			Kind:         kind,
+			Namespace:    meta.GetNamespace(),
+			Name:         meta.GetName(),
+			JobIteration: getIntFromLabels(meta.GetLabels(), config.KubeBurnerLabelJobIteration),
+			Replica:      getIntFromLabels(meta.GetLabels(), config.KubeBurnerLabelReplica),
+			Metadata:     i.Metadata,
+		}
+		i.objects[string(meta.GetUID())] = m
+	}
+	return m
+}
+
+// setReady records the ready latency of the object, and starts probing it when enabled
+func (i *ingressLatency) setReady(m *ingressMetric, probe ingressProbe) {
+	m.Ready = true
+	m.ReadyLatency = int(time.Since(m.Timestamp).Milliseconds())
+	m.Address = probe.address
+	log.Debugf("%s %s/%s ready in %dms", m.Kind, m.Namespace, m.Name, m.ReadyLatency)
+	if i.Config.Ingress.Probe {
+		i.probeWg.Add(1)
+		go i.probe(m, probe)
+	}
+}
+
+// handleIngress records when the load balancer address of the Ingress is populated
+func (i *ingressLatency) handleIngress(obj any) {
+	ingress := obj.(*networkingv1.Ingress)
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	m := i.trackObject(ingressKind, ingress)
+	if m.Ready || len(ingress.Status.LoadBalancer.Ingress) == 0 {
+		return
+	}
+	probe := ingressProbe{path: "/"}
+	if lb := ingress.Status.LoadBalancer.Ingress[0]; lb.Hostname != "" {
+		probe.address = lb.Hostname
+	} else {
+		probe.address = lb.IP
+	}
+	if len(ingress.Spec.Rules) > 0 {
+		rule := ingress.Spec.Rules[0]
+		probe.host = rule.Host
+		if rule.HTTP != nil && len(rule.HTTP.Paths) > 0 && rule.HTTP.Paths[0].Path != "" {
+			probe.path = rule.HTTP.Paths[0].Path
+		}
+	}
+	i.setReady(m, probe)
+}
+
+// handleHTTPRoute records when the HTTPRoute is accepted by all its parents, the address probed is the one of its first parent Gateway
+func (i *ingressLatency) handleHTTPRoute(obj any) {
+	route := obj.(*unstructured.Unstructured)
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	m := i.trackObject(httpRouteKind, route)
+	if m.Ready {
+		return
+	}
+	parents, _, _ := unstructured.NestedSlice(route.Object, "status", "parents")
+	parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	if len(parents) == 0 || len(parents) < len(parentRefs) {
+		return
+	}
+	for _, parent := range parents {
+		parentMap, _ := parent.(map[string]any)
+		conditions, _, _ := unstructured.NestedSlice(parentMap, "conditions")
+		if !routeConditionTrue(conditions, "Accepted") {
+			return
+		}
+	}
+	probe := ingressProbe{path: "/"}
+	if hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames"); len(hostnames) > 0 {
+		probe.host = hostnames[0]
+	}
+	if rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules"); len(rules) > 0 {
+		ruleMap, _ := rules[0].(map[string]any)
+		if matches, _, _ := unstructured.NestedSlice(ruleMap, "matches"); len(matches) > 0 {
+			matchMap, _ := matches[0].(map[string]any)
+			if path, _, _ := unstructured.NestedString(matchMap, "path", "value"); path != "" {
+				probe.path = path
+			}
+		}
+	}
+	if len(parentRefs) > 0 {
+		parentRef, _ := parentRefs[0].(map[string]any)
+		gateway := &ktypes.NamespacedName{Namespace: route.GetNamespace()}
+		if ns, ok := parentRef["namespace"].(string); ok && ns != "" {
+			gateway.Namespace = ns
+		}
+		gateway.Name, _ = parentRef["name"].(string)
+		probe.gateway = gateway
+	}
+	i.setReady(m, probe)
+}
+
+// routeConditionTrue returns true when the given condition of the route parent status is True
+func routeConditionTrue(conditions []any, conditionType string) bool {
+	for _, condition := range conditions {
+		conditionMap, _ := condition.(map[string]any)
+		if conditionMap["type"] == conditionType {
+			return conditionMap["status"] == string(metav1.ConditionTrue)
+		}
+	}
+	return false
+}
+
+// gatewayAddress returns the first address of the given Gateway
+func (i *ingressLatency) gatewayAddress(name ktypes.NamespacedName) string {
+	gateway, err := i.dynamicClient.Resource(gatewayGVR).Namespace(name.Namespace).Get(context.TODO(), name.Name, metav1.GetOptions{})
+	if err != nil {
+		log.Errorf("Error getting Gateway %s: %v", name, err)
+		return ""
+	}
+	addresses, _, _ := unstructured.NestedSlice(gateway.Object, "status", "addresses")
+	if len(addresses) == 0 {
+		log.Errorf("Gateway %s has no addresses", name)
+		return ""
+	}
+	addressMap, _ := addresses[0].(map[string]any)
+	address, _ := addressMap["value"].(string)
+	return address
+}
+
+// probe sends HTTP requests to the address of the object until the expected status code is returned or the probe timeout is reached
+func (i *ingressLatency) probe(m *ingressMetric, probe ingressProbe) {
+	defer i.probeWg.Done()
+	if probe.gateway != nil {
+		probe.address = i.gatewayAddress(*probe.gateway)
+		i.mu.Lock()
+		m.Address = probe.address
+		i.mu.Unlock()
+	}
+	if probe.address == "" {
+		return
+	}
+	url := fmt.Sprintf("http://%s%s", probe.address, probe.path)
+	err := wait.PollUntilContextTimeout(i.ctx, ingressProbeInterval, i.Config.Ingress.ProbeTimeout, true, func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return false, err
+		}
+		if probe.host != "" {
+			req.Host = probe.host
+		}
+		resp, err := i.httpClient.Do(req)
+		if err != nil {
+			log.Tracef("Probing %s %s/%s: %v", m.Kind, m.Namespace, m.Name, err)
+			return false, nil
+		}
+		resp.Body.Close()
+		return resp.StatusCode == i.Config.Ingress.ProbeStatus, nil
+	})
+	if err != nil {
+		log.Debugf("%s %s/%s not served at %s: %v", m.Kind, m.Namespace, m.Name, url, err)
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	m.Serving = true
+	m.ServingLatency = int(time.Since(m.Timestamp).Milliseconds())
+}
+
+// start ingressLatency measurement
+func (i *ingressLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	i.objects = map[string]*ingressMetric{}
+	i.dynamicClient = dynamic.NewForConfigOrDie(i.RestConfig)
+	i.httpClient = &http.Client{Timeout: 5 * time.Second}
+	i.ctx, i.cancel = context.WithCancel(context.Background())
+	i.stopCh = make(chan struct{})
+	labelSelector := i.objectSelector()
+	handlers := func(handle func(any)) *cache.ResourceEventHandlerFuncs {
+		return &cache.ResourceEventHandlerFuncs{
+			AddFunc: handle,
+			UpdateFunc: func(oldObj, newObj any) {
+				handle(newObj)
+			},
+		}
+	}
+	i.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient:    i.ClientSet.NetworkingV1().RESTClient().(*rest.RESTClient),
+				name:          "ingressWatcher",
+				resource:      "ingresses",
+				labelSelector: labelSelector,
+				handlers:      handlers(i.handleIngress),
+			},
+		},
+	)
+	// HTTPRoutes are only watched when the Gateway API is installed
+	resources, err := i.ClientSet.Discovery().ServerResourcesForGroupVersion(gatewayGroupVersion.String())
+	if err != nil || !slices.ContainsFunc(resources.APIResources, func(r metav1.APIResource) bool { return r.Name == httpRouteGVR.Resource }) {
+		log.Infof("%s not available, HTTPRoutes aren't measured", httpRouteGVR.GroupResource())
+		return nil
+	}
+	log.Infof("Creating %v latency watcher for %s", httpRouteGVR.Resource, i.JobConfig.Name)
+	informer := dynamicinformer.NewFilteredDynamicInformer(i.dynamicClient, httpRouteGVR, metav1.NamespaceAll, 0, cache.Indexers{}, func(options *metav1.ListOptions) {
+		options.LabelSelector = labelSelector
+	}).Informer()
+	informer.AddEventHandler(handlers(i.handleHTTPRoute))
+	go informer.Run(i.stopCh)
+	if !cache.WaitForCacheSync(i.stopCh, informer.HasSynced) {
+		log.Errorf("%v latency measurement error: timed out waiting for caches to sync", httpRouteGVR.Resource)
+	}
+	return nil
+}
+
+// collects ingressLatency measurements triggered in the past
+func (i *ingressLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to ingressLatency by design")
+	defer measurementWg.Done()
+}
+
+// stop ingressLatency measurement, the ongoing probes are cancelled
+func (i *ingressLatency) Stop() error {
+	defer close(i.stopCh)
+	i.cancel()
+	i.probeWg.Wait()
+	return i.StopMeasurement(i.normalizeMetrics, i.getLatency)
+}
+
+// normalizeMetrics returns the percentage of Ingresses and HTTPRoutes not ready
+func (i *ingressLatency) normalizeMetrics() float64 {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	var notReady, notServing int
+	for _, m := range i.objects {
+		if !m.Ready {
+			notReady++
+			log.Debugf("%s %s/%s wasn't ready before the end of the job", m.Kind, m.Namespace, m.Name)
+		} else if i.Config.Ingress.Probe && !m.Serving {
+			notServing++
+		}
+		i.normLatencies = append(i.normLatencies, *m)
+	}
+	if len(i.objects) == 0 {
+		return 0
+	}
+	if notServing > 0 {
+		log.Errorf("%d out of %d ready objects weren't served with status %d within %v", notServing, len(i.objects)-notReady, i.Config.Ingress.ProbeStatus, i.Config.Ingress.ProbeTimeout)
+	}
+	if notReady > 0 {
+		log.Errorf("%d out of %d objects weren't ready", notReady, len(i.objects))
+	}
+	return float64(notReady) / float64(len(i.objects)) * 100
+}
+
+func (i *ingressLatency) getLatency(normLatency any) map[string]float64 {
+	m := normLatency.(ingressMetric)
+	latencies := map[string]float64{}
+	if m.Ready {
+		latencies[ingressReady] = float64(m.ReadyLatency)
+	}
+	if m.Serving {
+		latencies[ingressServing] = float64(m.ServingLatency)
+	}
+	return latencies
+}
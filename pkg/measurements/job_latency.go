@@ -16,7 +16,6 @@ package measurements
 
 import (
 	"context"
-	"fmt"
 	"strings"
 	"sync"
 	"time"
@@ -36,6 +35,8 @@ import (
 
 const (
 	jobStartTimeMeasurement        = "StartTime"
+	jobActive                      = "Active"
+	jobActiveToComplete            = "ActiveToComplete"
 	jobLatencyMeasurement          = "jobLatencyMeasurement"
 	jobLatencyQuantilesMeasurement = "jobLatencyQuantilesMeasurement"
 )
@@ -43,23 +44,30 @@ const (
 var (
 	supportedJobConditions = map[string]struct{}{
 		string(batchv1.JobComplete): {},
+		jobActive:                   {},
+		jobActiveToComplete:         {},
 	}
 )
 
 type jobMetric struct {
 	Timestamp         time.Time `json:"timestamp"`
 	startTime         time.Time
+	active            time.Time
 	jobComplete       time.Time
-	StartTimeLatency  int    `json:"startTimeLatency"`
-	CompletionLatency int    `json:"completionLatency"`
-	MetricName        string `json:"metricName"`
-	UUID              string `json:"uuid"`
-	JobName           string `json:"jobName,omitempty"`
-	JobIteration      int    `json:"jobIteration"`
-	Replica           int    `json:"replica"`
-	Namespace         string `json:"namespace"`
-	Name              string `json:"k8sJobName"`
-	Metadata          any    `json:"metadata,omitempty"`
+	StartTimeLatency  int `json:"startTimeLatency"`
+	CompletionLatency int `json:"completionLatency"`
+	// ActiveLatency time from the job creation until it had active pods for the first time, the time spent pending
+	ActiveLatency int `json:"activeLatency"`
+	// ActiveToCompleteLatency time from the job having active pods until its completion, the time spent running
+	ActiveToCompleteLatency int    `json:"activeToCompleteLatency"`
+	MetricName              string `json:"metricName"`
+	UUID                    string `json:"uuid"`
+	JobName                 string `json:"jobName,omitempty"`
+	JobIteration            int    `json:"jobIteration"`
+	Replica                 int    `json:"replica"`
+	Namespace               string `json:"namespace"`
+	Name                    string `json:"k8sJobName"`
+	Metadata                any    `json:"metadata,omitempty"`
 }
 
 type jobLatency struct {
@@ -88,8 +96,13 @@ func (jlmf jobLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, c
 func (j *jobLatency) handleCreateJob(obj any) {
 	job := obj.(*batchv1.Job)
 	jobLabels := job.GetLabels()
+	var active time.Time
+	if job.Status.Active > 0 {
+		active = time.Now().UTC()
+	}
 	j.metrics.LoadOrStore(string(job.UID), jobMetric{
 		Timestamp:    job.CreationTimestamp.UTC(),
+		active:       active,
 		Namespace:    job.Namespace,
 		Name:         job.Name,
 		MetricName:   jobLatencyMeasurement,
@@ -106,6 +119,9 @@ func (j *jobLatency) handleUpdateJob(obj any) {
 	if value, exists := j.metrics.Load(string(job.UID)); exists {
 		jm := value.(jobMetric)
 		if jm.jobComplete.IsZero() {
+			if jm.active.IsZero() && job.Status.Active > 0 {
+				jm.active = time.Now().UTC()
+			}
 			for _, c := range job.Status.Conditions {
 				if c.Status == corev1.ConditionTrue {
 					switch c.Type {
@@ -129,7 +145,7 @@ func (j *jobLatency) Start(measurementWg *sync.WaitGroup) error {
 				restClient:    j.ClientSet.BatchV1().RESTClient().(*rest.RESTClient),
 				name:          "jobWatcher",
 				resource:      "jobs",
-				labelSelector: fmt.Sprintf("kube-burner-runid=%v", j.Runid),
+				labelSelector: j.objectSelector(),
 				handlers: &cache.ResourceEventHandlerFuncs{
 					AddFunc: j.handleCreateJob,
 					UpdateFunc: func(oldObj, newObj any) {
@@ -204,6 +220,12 @@ func (j *jobLatency) normalizeMetrics() float64 {
 			m.StartTimeLatency = 0
 		}
 		m.CompletionLatency = int(m.jobComplete.Sub(m.Timestamp).Milliseconds())
+		// Jobs completed before being observed with active pods, or collected after running, fall back to their start time
+		if m.active.IsZero() || m.active.After(m.jobComplete) {
+			m.active = m.startTime
+		}
+		m.ActiveLatency = max(0, int(m.active.Sub(m.Timestamp).Milliseconds()))
+		m.ActiveToCompleteLatency = max(0, int(m.jobComplete.Sub(m.active).Milliseconds()))
 		j.normLatencies = append(j.normLatencies, m)
 		return true
 	})
@@ -215,5 +237,7 @@ func (j *jobLatency) getLatency(normLatency any) map[string]float64 {
 	return map[string]float64{
 		jobStartTimeMeasurement:     float64(jobMetric.StartTimeLatency),
 		string(batchv1.JobComplete): float64(jobMetric.CompletionLatency),
+		jobActive:                   float64(jobMetric.ActiveLatency),
+		jobActiveToComplete:         float64(jobMetric.ActiveToCompleteLatency),
 	}
 }
@@ -0,0 +1,222 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	leaderElectionMeasurement = "leaderElectionMeasurement"
+	leaderElectionSummary     = "leaderElectionSummary"
+)
+
+// leaderTransition change of the holder of a lease
+type leaderTransition struct {
+	Timestamp      time.Time `json:"timestamp"`
+	UUID           string    `json:"uuid"`
+	JobName        string    `json:"jobName,omitempty"`
+	MetricName     string    `json:"metricName"`
+	Namespace      string    `json:"namespace"`
+	Lease          string    `json:"lease"`
+	PreviousHolder string    `json:"previousHolder"`
+	Holder         string    `json:"holder"`
+	Metadata       any       `json:"metadata,omitempty"`
+}
+
+// leaseKey identifies a lease
+type leaseKey struct {
+	namespace string
+	name      string
+}
+
+// leaseSummary number of leader transitions of a lease during the measurement
+type leaseSummary struct {
+	Timestamp   time.Time `json:"timestamp"`
+	UUID        string    `json:"uuid"`
+	JobName     string    `json:"jobName,omitempty"`
+	MetricName  string    `json:"metricName"`
+	Namespace   string    `json:"namespace"`
+	Lease       string    `json:"lease"`
+	Transitions int       `json:"transitions"`
+	// Holder holder of the lease at the end of the measurement
+	Holder   string `json:"holder"`
+	Metadata any    `json:"metadata,omitempty"`
+}
+
+type leaderElection struct {
+	BaseMeasurement
+	mu    sync.Mutex
+	start time.Time
+	// holders current holder of each lease
+	holders map[leaseKey]string
+	// transitions number of leader transitions of each lease
+	transitions map[leaseKey]int
+	leaderDocs  []any
+	summaries   []any
+}
+
+type leaderElectionMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newLeaderElectionMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if len(measurement.LeaderElection.Namespaces) == 0 {
+		measurement.LeaderElection.Namespaces = []string{metav1.NamespaceSystem}
+	}
+	return leaderElectionMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (lemf leaderElectionMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &leaderElection{
+		BaseMeasurement: lemf.NewBaseLatency(jobConfig, clientSet, restConfig, leaderElectionMeasurement, leaderElectionSummary, embedCfg),
+	}
+}
+
+// handleLease records the holder changes of the lease, the holder when the measurement starts isn't a transition.
+// A lease released by its holder, without holder, isn't a transition either, only the next acquisition is
+func (l *leaderElection) handleLease(obj any) {
+	lease := obj.(*coordinationv1.Lease)
+	if len(l.Config.LeaderElection.Leases) > 0 && !slices.Contains(l.Config.LeaderElection.Leases, lease.Name) {
+		return
+	}
+	var holder string
+	if lease.Spec.HolderIdentity != nil {
+		holder = *lease.Spec.HolderIdentity
+	}
+	key := leaseKey{lease.Namespace, lease.Name}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	previousHolder, known := l.holders[key]
+	if !known {
+		l.holders[key] = holder
+		l.transitions[key] = 0
+		return
+	}
+	if holder == "" || holder == previousHolder {
+		return
+	}
+	l.holders[key] = holder
+	l.transitions[key]++
+	log.Warnf("Lease %s/%s acquired by %s, previous holder: %s", lease.Namespace, lease.Name, holder, previousHolder)
+	l.leaderDocs = append(l.leaderDocs, leaderTransition{
+		Timestamp:      time.Now().UTC(),
+		UUID:           l.Uuid,
+		JobName:        l.JobConfig.Name,
+		MetricName:     leaderElectionMeasurement,
+		Namespace:      lease.Namespace,
+		Lease:          lease.Name,
+		PreviousHolder: previousHolder,
+		Holder:         holder,
+		Metadata:       l.Metadata,
+	})
+}
+
+// start leaderElection measurement
+func (l *leaderElection) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	l.start = time.Now().UTC()
+	l.holders = map[leaseKey]string{}
+	l.transitions = map[leaseKey]int{}
+	l.leaderDocs, l.summaries = nil, nil
+	var measurementWatchers []MeasurementWatcher
+	for _, namespace := range l.Config.LeaderElection.Namespaces {
+		measurementWatchers = append(measurementWatchers, MeasurementWatcher{
+			restClient: l.ClientSet.CoordinationV1().RESTClient().(*rest.RESTClient),
+			name:       fmt.Sprintf("leaseWatcher-%s", namespace),
+			resource:   "leases",
+			namespace:  namespace,
+			handlers: &cache.ResourceEventHandlerFuncs{
+				AddFunc: l.handleLease,
+				UpdateFunc: func(oldObj, newObj any) {
+					l.handleLease(newObj)
+				},
+			},
+		})
+	}
+	l.startMeasurement(measurementWatchers)
+	return nil
+}
+
+// collects leaderElection measurements triggered in the past
+func (l *leaderElection) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to leaderElection by design")
+	defer measurementWg.Done()
+}
+
+// Stop summarizes the leader transitions of each lease
+func (l *leaderElection) Stop() error {
+	l.stopWatchers()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	leases := make([]leaseKey, 0, len(l.transitions))
+	for key := range l.transitions {
+		leases = append(leases, key)
+	}
+	sort.Slice(leases, func(i, j int) bool {
+		if leases[i].namespace != leases[j].namespace {
+			return leases[i].namespace < leases[j].namespace
+		}
+		return leases[i].name < leases[j].name
+	})
+	var total int
+	for _, key := range leases {
+		transitions := l.transitions[key]
+		total += transitions
+		if transitions > 0 {
+			log.Infof("%s: lease %s/%s changed its leader %d times", l.JobConfig.Name, key.namespace, key.name, transitions)
+		}
+		l.summaries = append(l.summaries, leaseSummary{
+			Timestamp:   l.start,
+			UUID:        l.Uuid,
+			JobName:     l.JobConfig.Name,
+			MetricName:  leaderElectionSummary,
+			Namespace:   key.namespace,
+			Lease:       key.name,
+			Transitions: transitions,
+			Holder:      l.holders[key],
+			Metadata:    l.Metadata,
+		})
+	}
+	log.Infof("%s: %d leader transitions across %d leases", l.JobConfig.Name, total, len(leases))
+	return nil
+}
+
+func (l *leaderElection) Index(jobName string, indexerList map[string]indexers.Indexer) {
+	metricMap := map[string][]any{
+		leaderElectionSummary: l.summaries,
+	}
+	if len(l.leaderDocs) > 0 {
+		metricMap[leaderElectionMeasurement] = l.leaderDocs
+	}
+	l.indexLatencyMeasurement(jobName, metricMap, indexerList)
+}
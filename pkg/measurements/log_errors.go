@@ -0,0 +1,339 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	logErrorsMeasurement     = "logErrorsMeasurement"
+	logErrorsSummary         = "logErrorsSummary"
+	defaultLogErrorsMaxLines = 20
+	// maxLogErrorLineLength matching lines are truncated to this length in the summary
+	maxLogErrorLineLength = 1024
+	// logErrorsReadTimeout timeout to read the logs of the containers without a log stream by the end of the job
+	logErrorsReadTimeout = 5 * time.Minute
+)
+
+// logErrorMatch log line matching an error pattern
+type logErrorMatch struct {
+	Timestamp time.Time `json:"timestamp"`
+	Namespace string    `json:"namespace"`
+	PodName   string    `json:"podName"`
+	Container string    `json:"container"`
+	Pattern   string    `json:"pattern"`
+	Line      string    `json:"line"`
+}
+
+// logErrorSummary number of log lines matching the error patterns during the job
+type logErrorSummary struct {
+	Timestamp  time.Time `json:"timestamp"`
+	UUID       string    `json:"uuid"`
+	JobName    string    `json:"jobName,omitempty"`
+	MetricName string    `json:"metricName"`
+	Matches    int       `json:"matches"`
+	// PatternMatches matching lines of each pattern, a line matching several patterns is accounted by the first one
+	PatternMatches map[string]int `json:"patternMatches"`
+	// Pods pods with matching lines
+	Pods int `json:"pods"`
+	// Containers containers whose logs were read, UnreadContainers the ones whose logs couldn't be read, or only partially
+	Containers       int             `json:"containers"`
+	UnreadContainers int             `json:"unreadContainers,omitempty"`
+	Lines            []logErrorMatch `json:"lines,omitempty"`
+	Metadata         any             `json:"metadata,omitempty"`
+}
+
+// logStream log stream of a pod container
+type logStream struct {
+	namespace string
+	podName   string
+	container string
+	// read whether the logs were read, even partially
+	read bool
+	// failed reading the logs failed midway, i.e. a line longer than the scanner buffer
+	failed bool
+}
+
+type logErrors struct {
+	BaseMeasurement
+	patterns []*regexp.Regexp
+	mu       sync.Mutex
+	start    time.Time
+	// containers log streams indexed by pod UID and container
+	containers map[string]*logStream
+	matches    int
+	// patternMatches matching lines per pattern, podMatches pods with matching lines indexed by namespace and name
+	patternMatches map[string]int
+	podMatches     map[string]struct{}
+	lines          []logErrorMatch
+	// streams limits the number of log streams open at the same time
+	streams  chan struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
+	streamWg sync.WaitGroup
+	summary  logErrorSummary
+}
+
+type logErrorsMeasurementFactory struct {
+	BaseMeasurementFactory
+	patterns []*regexp.Regexp
+}
+
+func newLogErrorsMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if len(measurement.LogErrors.Patterns) == 0 {
+		return nil, fmt.Errorf("logErrors patterns are required")
+	}
+	var patterns []*regexp.Regexp
+	for _, p := range measurement.LogErrors.Patterns {
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid logErrors pattern %s: %v", p, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	if measurement.LogErrors.MaxMatches < 0 || measurement.LogErrors.MaxLines < 0 || measurement.LogErrors.MaxStreams < 0 {
+		return nil, fmt.Errorf("logErrors maxMatches, maxLines and maxStreams must be >= 0")
+	}
+	if measurement.LogErrors.MaxLines == 0 {
+		measurement.LogErrors.MaxLines = defaultLogErrorsMaxLines
+	}
+	if measurement.LogErrors.MaxStreams == 0 {
+		measurement.LogErrors.MaxStreams = defaultFirstLogMaxStreams
+	}
+	return logErrorsMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+		patterns:               patterns,
+	}, nil
+}
+
+func (lemf logErrorsMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &logErrors{
+		BaseMeasurement: lemf.NewBaseLatency(jobConfig, clientSet, restConfig, logErrorsMeasurement, logErrorsSummary, embedCfg),
+		patterns:        lemf.patterns,
+	}
+}
+
+// handlePod starts following the logs of the pod containers once they're started
+func (l *logErrors) handlePod(obj any) {
+	pod := obj.(*corev1.Pod)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// No new streams are started once the measurement is stopped
+	if l.ctx.Err() != nil {
+		return
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if l.Config.LogErrors.Container != "" && cs.Name != l.Config.LogErrors.Container {
+			continue
+		}
+		key := string(pod.UID) + "/" + cs.Name
+		if _, exists := l.containers[key]; exists || (cs.State.Running == nil && cs.State.Terminated == nil) {
+			continue
+		}
+		s := &logStream{namespace: pod.Namespace, podName: pod.Name, container: cs.Name}
+		l.containers[key] = s
+		l.streamWg.Add(1)
+		go func() {
+			defer l.streamWg.Done()
+			select {
+			case l.streams <- struct{}{}:
+				defer func() { <-l.streams }()
+			case <-l.ctx.Done():
+				return
+			}
+			l.readLogs(l.ctx, s, true)
+		}()
+	}
+}
+
+// readLogs reads the logs of the container, following them until the container exits or the context is cancelled
+func (l *logErrors) readLogs(ctx context.Context, s *logStream, follow bool) {
+	stream, err := l.ClientSet.CoreV1().Pods(s.namespace).GetLogs(s.podName, &corev1.PodLogOptions{
+		Container:  s.container,
+		Follow:     follow,
+		Timestamps: true,
+	}).Stream(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Debugf("Error reading logs of pod %s/%s: %v", s.namespace, s.podName, err)
+		}
+		return
+	}
+	defer stream.Close()
+	l.mu.Lock()
+	s.read = true
+	l.mu.Unlock()
+	if err := l.scanLogs(s, stream); err != nil && ctx.Err() == nil {
+		log.Warnf("Error reading logs of pod %s/%s container %s: %v", s.namespace, s.podName, s.container, err)
+		l.mu.Lock()
+		s.failed = true
+		l.mu.Unlock()
+	}
+}
+
+// scanLogs matches the log lines read from r against the error patterns
+func (l *logErrors) scanLogs(s *logStream, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+	for scanner.Scan() {
+		timestamp, line, _ := strings.Cut(scanner.Text(), " ")
+		for _, pattern := range l.patterns {
+			if pattern.MatchString(line) {
+				t, _ := time.Parse(time.RFC3339Nano, timestamp)
+				l.addMatch(s, pattern.String(), line, t.UTC())
+				break
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+func (l *logErrors) addMatch(s *logStream, pattern, line string, timestamp time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.matches++
+	l.patternMatches[pattern]++
+	l.podMatches[s.namespace+"/"+s.podName] = struct{}{}
+	if len(l.lines) < l.Config.LogErrors.MaxLines {
+		if len(line) > maxLogErrorLineLength {
+			line = line[:maxLogErrorLineLength]
+		}
+		l.lines = append(l.lines, logErrorMatch{
+			Timestamp: timestamp,
+			Namespace: s.namespace,
+			PodName:   s.podName,
+			Container: s.container,
+			Pattern:   pattern,
+			Line:      line,
+		})
+	}
+}
+
+// start logErrors measurement
+func (l *logErrors) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	l.start = time.Now().UTC()
+	l.containers = map[string]*logStream{}
+	l.matches = 0
+	l.patternMatches = map[string]int{}
+	l.podMatches = map[string]struct{}{}
+	l.lines = nil
+	l.streams = make(chan struct{}, l.Config.LogErrors.MaxStreams)
+	l.ctx, l.cancel = context.WithCancel(context.Background())
+	l.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient:    l.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "podWatcher",
+				resource:      "pods",
+				labelSelector: l.objectSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: l.handlePod,
+					UpdateFunc: func(oldObj, newObj any) {
+						l.handlePod(newObj)
+					},
+				},
+			},
+		},
+	)
+	return nil
+}
+
+// collects logErrors measurements triggered in the past
+func (l *logErrors) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to logErrors by design")
+	defer measurementWg.Done()
+}
+
+// Stop closes the log streams still open, and reads the logs of the containers that didn't get a stream while the job was running.
+// The measurement fails when the matching lines exceed maxMatches
+func (l *logErrors) Stop() error {
+	l.stopWatchers()
+	l.mu.Lock()
+	l.cancel()
+	l.mu.Unlock()
+	l.streamWg.Wait()
+	ctx, cancel := context.WithTimeout(context.Background(), logErrorsReadTimeout)
+	defer cancel()
+	var readWg sync.WaitGroup
+	for _, s := range l.containers {
+		if s.read {
+			continue
+		}
+		readWg.Add(1)
+		go func() {
+			defer readWg.Done()
+			l.streams <- struct{}{}
+			defer func() { <-l.streams }()
+			l.readLogs(ctx, s, false)
+		}()
+	}
+	readWg.Wait()
+	var unread int
+	for _, s := range l.containers {
+		if !s.read || s.failed {
+			unread++
+		}
+	}
+	l.summary = logErrorSummary{
+		Timestamp:        l.start,
+		UUID:             l.Uuid,
+		JobName:          l.JobConfig.Name,
+		MetricName:       logErrorsSummary,
+		Matches:          l.matches,
+		PatternMatches:   l.patternMatches,
+		Pods:             len(l.podMatches),
+		Containers:       len(l.containers) - unread,
+		UnreadContainers: unread,
+		Lines:            l.lines,
+		Metadata:         l.Metadata,
+	}
+	log.Infof("%s: %d log lines from %d pods matched the error patterns, %d containers read", l.JobConfig.Name, l.matches, len(l.podMatches), len(l.containers)-unread)
+	if unread > 0 {
+		log.Warnf("%s: the logs of %d containers couldn't be read", l.JobConfig.Name, unread)
+	}
+	for _, m := range l.lines {
+		log.Debugf("%s/%s %s: %s", m.Namespace, m.PodName, m.Container, m.Line)
+	}
+	if l.matches > l.Config.LogErrors.MaxMatches {
+		return fmt.Errorf("logErrors: %d log lines matched the error patterns, higher than the maximum of %d", l.matches, l.Config.LogErrors.MaxMatches)
+	}
+	return nil
+}
+
+func (l *logErrors) Index(jobName string, indexerList map[string]indexers.Indexer) {
+	metricMap := map[string][]any{
+		logErrorsSummary: {l.summary},
+	}
+	l.indexLatencyMeasurement(jobName, metricMap, indexerList)
+}
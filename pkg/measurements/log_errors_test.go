@@ -0,0 +1,78 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+)
+
+func newTestLogErrors(maxLines int) *logErrors {
+	return &logErrors{
+		BaseMeasurement: BaseMeasurement{Config: types.Measurement{LogErrors: types.LogErrors{MaxLines: maxLines}}},
+		patterns:        []*regexp.Regexp{regexp.MustCompile("panic"), regexp.MustCompile("error")},
+		patternMatches:  map[string]int{},
+		podMatches:      map[string]struct{}{},
+	}
+}
+
+func TestLogErrorsAddMatch(t *testing.T) {
+	l := newTestLogErrors(2)
+	s := &logStream{namespace: "ns", podName: "pod", container: "app"}
+	longLine := "error " + strings.Repeat("x", 2*maxLogErrorLineLength)
+	for _, line := range []string{longLine, "error 2", "error 3"} {
+		l.addMatch(s, "error", line, time.Now())
+	}
+	if l.matches != 3 || l.patternMatches["error"] != 3 {
+		t.Errorf("expected 3 matches, got %d, %v", l.matches, l.patternMatches)
+	}
+	if len(l.lines) != 2 {
+		t.Fatalf("expected the lines to be capped to 2, got %d", len(l.lines))
+	}
+	if len(l.lines[0].Line) != maxLogErrorLineLength {
+		t.Errorf("expected the line to be truncated to %d, got %d", maxLogErrorLineLength, len(l.lines[0].Line))
+	}
+	if l.lines[1].Line != "error 2" {
+		t.Errorf("unexpected second line: %s", l.lines[1].Line)
+	}
+}
+
+func TestLogErrorsScanLogs(t *testing.T) {
+	l := newTestLogErrors(10)
+	s := &logStream{namespace: "ns", podName: "pod", container: "app"}
+	logs := strings.Join([]string{
+		"2024-01-01T00:00:00.000000000Z starting",
+		"2024-01-01T00:00:01.000000000Z panic: error in handler",
+		"2024-01-01T00:00:02.000000000Z error: connection refused",
+	}, "\n")
+	if err := l.scanLogs(s, strings.NewReader(logs)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Lines matching several patterns are accounted by the first one
+	if l.patternMatches["panic"] != 1 || l.patternMatches["error"] != 1 {
+		t.Errorf("unexpected pattern matches: %v", l.patternMatches)
+	}
+	if want := time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC); !l.lines[0].Timestamp.Equal(want) {
+		t.Errorf("expected timestamp %v, got %v", want, l.lines[0].Timestamp)
+	}
+	// Lines longer than the scanner buffer stop the scan with an error
+	if err := l.scanLogs(s, strings.NewReader("2024-01-01T00:00:00Z "+strings.Repeat("x", maxLogLineSize+1))); err == nil {
+		t.Error("expected error scanning a line longer than the buffer")
+	}
+}
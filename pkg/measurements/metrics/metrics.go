@@ -15,8 +15,13 @@
 package metrics
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kube-burner/kube-burner/pkg/measurements/types"
@@ -39,6 +44,112 @@ type LatencyQuantiles struct {
 	MetricName   string    `json:"metricName"`
 	JobName      string    `json:"jobName,omitempty"`
 	Metadata     any       `json:"metadata,omitempty"`
+	// CustomQuantiles quantiles requested by the measurement configuration, when set they replace P99, P95 and P50
+	CustomQuantiles []Quantile `json:"-"`
+}
+
+// Quantile holds the value of a custom quantile
+type Quantile struct {
+	Name  string
+	Value int
+}
+
+// QuantileName returns the name of the given quantile, 0.99 is named P99 and 0.999 is named P999.
+// The percentile is rounded to two decimals to drop float artifacts, i.e. 0.29*100 is 28.999999999999996
+func QuantileName(quantile float64) string {
+	return "P" + strings.ReplaceAll(strconv.FormatFloat(math.Round(quantile*1e4)/1e2, 'f', -1, 64), ".", "")
+}
+
+// MarshalJSON encodes the custom quantiles, when configured, in the requested order instead of P99, P95 and P50
+func (lq LatencyQuantiles) MarshalJSON() ([]byte, error) {
+	type rawLatencyQuantiles LatencyQuantiles
+	if len(lq.CustomQuantiles) == 0 {
+		return json.Marshal(rawLatencyQuantiles(lq))
+	}
+	type field struct {
+		key   string
+		value any
+		omit  bool
+	}
+	fields := []field{
+		{key: "quantileName", value: lq.QuantileName},
+		{key: "uuid", value: lq.UUID},
+	}
+	for _, q := range lq.CustomQuantiles {
+		fields = append(fields, field{key: q.Name, value: q.Value})
+	}
+	fields = append(fields,
+		field{key: "min", value: lq.Min},
+		field{key: "max", value: lq.Max},
+		field{key: "avg", value: lq.Avg},
+		field{key: "timestamp", value: lq.Timestamp},
+		field{key: "metricName", value: lq.MetricName},
+		field{key: "jobName", value: lq.JobName, omit: lq.JobName == ""},
+		field{key: "metadata", value: lq.Metadata, omit: lq.Metadata == nil},
+	)
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for _, f := range fields {
+		if f.omit {
+			continue
+		}
+		v, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, "%q:%s", f.key, v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Summary returns a human readable summary of the quantiles, values are divided by scale
+func (lq LatencyQuantiles) Summary(scale int, unit string) string {
+	var summary []string
+	if len(lq.CustomQuantiles) > 0 {
+		for _, q := range lq.CustomQuantiles {
+			summary = append(summary, fmt.Sprintf("%s: %d%s", q.Name, q.Value/scale, unit))
+		}
+	} else {
+		summary = append(summary, fmt.Sprintf("99th: %d%s", lq.P99/scale, unit))
+	}
+	summary = append(summary, fmt.Sprintf("max: %d%s avg: %d%s", lq.Max/scale, unit, lq.Avg/scale, unit))
+	return strings.Join(summary, " ")
+}
+
+// CSVRecords returns a CSV record per quantile with the job name, the measurement, the metric, the quantile and its value
+func (lq LatencyQuantiles) CSVRecords() [][]string {
+	var records [][]string
+	record := func(quantile string, value int) {
+		records = append(records, []string{lq.JobName, lq.MetricName, lq.QuantileName, quantile, strconv.Itoa(value)})
+	}
+	if len(lq.CustomQuantiles) > 0 {
+		for _, q := range lq.CustomQuantiles {
+			record(q.Name, q.Value)
+		}
+	} else {
+		record("P99", lq.P99)
+		record("P95", lq.P95)
+		record("P50", lq.P50)
+	}
+	record("min", lq.Min)
+	record("max", lq.Max)
+	record("avg", lq.Avg)
+	return records
+}
+
+// quantileValue returns the value of the given quantile, custom quantiles have precedence over the struct fields
+func (lq LatencyQuantiles) quantileValue(name string) int64 {
+	for _, q := range lq.CustomQuantiles {
+		if q.Name == name {
+			return int64(q.Value)
+		}
+	}
+	// Required to access the attribute by name
+	return reflect.ValueOf(lq).FieldByName(name).Int()
 }
 
 // CheckThreshold checks latency thresholds
@@ -49,9 +160,7 @@ func CheckThreshold(thresholds []types.LatencyThreshold, quantiles []any) error
 	for _, phase := range thresholds {
 		for _, pq := range quantiles {
 			if phase.ConditionType == pq.(LatencyQuantiles).QuantileName {
-				// Required to access the attribute by name
-				r := reflect.ValueOf(pq.(LatencyQuantiles))
-				v := r.FieldByName(phase.Metric).Int()
+				v := pq.(LatencyQuantiles).quantileValue(phase.Metric)
 				if v > phase.Threshold.Milliseconds() {
 					latency := float32(v) / 1000
 					err := fmt.Errorf("podLatency: %s %s latency (%.2fs) higher than configured threshold: %v", phase.Metric, phase.ConditionType, latency, phase.Threshold)
@@ -63,7 +172,8 @@ func CheckThreshold(thresholds []types.LatencyThreshold, quantiles []any) error
 	return utilerrors.NewAggregate(errs)
 }
 
-func NewLatencySummary(input []float64, name string) LatencyQuantiles {
+// NewLatencySummary calculates the latency quantiles of the given input, the custom quantiles are calculated when given
+func NewLatencySummary(input []float64, name string, quantiles ...float64) LatencyQuantiles {
 	latencyQuantiles := LatencyQuantiles{
 		QuantileName: name,
 		Timestamp:    time.Now().UTC(),
@@ -80,5 +190,12 @@ func NewLatencySummary(input []float64, name string) LatencyQuantiles {
 	latencyQuantiles.Max = int(val)
 	val, _ = stats.Mean(input)
 	latencyQuantiles.Avg = int(val)
+	for _, quantile := range quantiles {
+		val, _ = stats.Percentile(input, quantile*100)
+		latencyQuantiles.CustomQuantiles = append(latencyQuantiles.CustomQuantiles, Quantile{
+			Name:  QuantileName(quantile),
+			Value: int(val),
+		})
+	}
 	return latencyQuantiles
 }
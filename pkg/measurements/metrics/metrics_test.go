@@ -0,0 +1,40 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import "testing"
+
+func TestQuantileName(t *testing.T) {
+	tests := []struct {
+		quantile float64
+		expected string
+	}{
+		{0.99, "P99"},
+		{0.95, "P95"},
+		{0.5, "P50"},
+		{0.29, "P29"},
+		{0.57, "P57"},
+		{0.999, "P999"},
+		{0.9999, "P9999"},
+		{0.05, "P5"},
+		{0.005, "P05"},
+		{1, "P100"},
+	}
+	for _, tt := range tests {
+		if got := QuantileName(tt.quantile); got != tt.expected {
+			t.Errorf("QuantileName(%v) = %s, expected %s", tt.quantile, got, tt.expected)
+		}
+	}
+}
@@ -495,7 +495,7 @@ func (n *netpolLatency) Start(measurementWg *sync.WaitGroup) error {
 				restClient:    n.ClientSet.NetworkingV1().RESTClient().(*rest.RESTClient),
 				name:          "netpolWatcher",
 				resource:      "networkpolicies",
-				labelSelector: fmt.Sprintf("kube-burner-runid=%v", n.Runid),
+				labelSelector: n.objectSelector(),
 				handlers: &cache.ResourceEventHandlerFuncs{
 					AddFunc: n.handleCreateNetpol,
 				},
@@ -527,7 +527,7 @@ func (n *netpolLatency) Stop() error {
 	for _, q := range n.latencyQuantiles {
 		pq := q.(metrics.LatencyQuantiles)
 		// Divide nanoseconds by 1e6 to get milliseconds
-		log.Infof("%s: %s 50th: %d 99th: %d max: %d avg: %d", n.JobConfig.Name, pq.QuantileName, pq.P50, pq.P99, pq.Max, pq.Avg)
+		log.Infof("%s: %s %s", n.JobConfig.Name, pq.QuantileName, pq.Summary(1, ""))
 
 	}
 	return nil
@@ -546,7 +546,7 @@ func (n *netpolLatency) normalizeMetrics() {
 		return true
 	})
 	calcSummary := func(name string, inputLatencies []float64) metrics.LatencyQuantiles {
-		latencySummary := metrics.NewLatencySummary(inputLatencies, name)
+		latencySummary := metrics.NewLatencySummary(inputLatencies, name, n.Config.Quantiles...)
 		latencySummary.UUID = n.Uuid
 		latencySummary.Timestamp = time.Now().UTC()
 		latencySummary.Metadata = n.Metadata
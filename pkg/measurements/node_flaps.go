@@ -0,0 +1,243 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	nodeFlapsMeasurement = "nodeFlapMeasurement"
+	nodeFlapsSummary     = "nodeFlapSummary"
+)
+
+// nodeFlap Ready condition transition of a node
+type nodeFlap struct {
+	Timestamp  time.Time `json:"timestamp"`
+	UUID       string    `json:"uuid"`
+	JobName    string    `json:"jobName,omitempty"`
+	MetricName string    `json:"metricName"`
+	NodeName   string    `json:"nodeName"`
+	// Ready whether the node transitioned to Ready or to NotReady
+	Ready bool `json:"ready"`
+	// Status and reason of the Ready condition
+	Status   string `json:"status"`
+	Reason   string `json:"reason,omitempty"`
+	Metadata any    `json:"metadata,omitempty"`
+}
+
+// nodeFlapSummary number of NotReady transitions and total NotReady time of the nodes during the measurement
+type nodeFlapSummary struct {
+	Timestamp  time.Time `json:"timestamp"`
+	UUID       string    `json:"uuid"`
+	JobName    string    `json:"jobName,omitempty"`
+	MetricName string    `json:"metricName"`
+	Flaps      int       `json:"flaps"`
+	// NotReadyDuration total time in seconds the nodes were NotReady
+	NotReadyDuration float64 `json:"notReadyDuration"`
+	// NotReadyNodes nodes that were NotReady at any time during the measurement
+	NotReadyNodes []string `json:"notReadyNodes,omitempty"`
+	Metadata      any      `json:"metadata,omitempty"`
+}
+
+type nodeFlaps struct {
+	BaseMeasurement
+	mu    sync.Mutex
+	start time.Time
+	// notReadySince time each node became NotReady, nodes currently Ready aren't present
+	notReadySince map[string]time.Time
+	// notReadyDuration NotReady time of each node, excluding the current NotReady period
+	notReadyDuration map[string]time.Duration
+	flaps            []any
+	summary          nodeFlapSummary
+}
+
+type nodeFlapsMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newNodeFlapsMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if measurement.NodeFlaps.MaxFlaps != nil && *measurement.NodeFlaps.MaxFlaps < 0 {
+		return nil, fmt.Errorf("nodeFlaps maxFlaps must be >= 0")
+	}
+	if measurement.NodeFlaps.MaxNotReadyDuration < 0 {
+		return nil, fmt.Errorf("nodeFlaps maxNotReadyDuration must be >= 0")
+	}
+	return nodeFlapsMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (nfmf nodeFlapsMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &nodeFlaps{
+		BaseMeasurement: nfmf.NewBaseLatency(jobConfig, clientSet, restConfig, nodeFlapsMeasurement, nodeFlapsSummary, embedCfg),
+	}
+}
+
+// nodeReadyCondition returns the Ready condition of the node, nil when it's not reported yet
+func nodeReadyCondition(node *corev1.Node) *corev1.NodeCondition {
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == corev1.NodeReady {
+			return &node.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// handleNode records the Ready condition transitions of the node, the nodes already NotReady when the measurement starts
+// are accounted as NotReady since the start, without a transition
+func (n *nodeFlaps) handleNode(obj any) {
+	node := obj.(*corev1.Node)
+	condition := nodeReadyCondition(node)
+	if condition == nil {
+		return
+	}
+	ready := condition.Status == corev1.ConditionTrue
+	now := time.Now().UTC()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	since, notReady := n.notReadySince[node.Name]
+	_, known := n.notReadyDuration[node.Name]
+	switch {
+	case !known:
+		n.notReadyDuration[node.Name] = 0
+		if !ready {
+			log.Warnf("Node %s is %s when the measurement starts: %s", node.Name, condition.Status, condition.Reason)
+			n.notReadySince[node.Name] = n.start
+		}
+		return
+	case ready && notReady:
+		n.notReadyDuration[node.Name] += now.Sub(since)
+		delete(n.notReadySince, node.Name)
+		log.Infof("Node %s is Ready again after %v", node.Name, now.Sub(since).Round(time.Second))
+	case !ready && !notReady:
+		n.notReadySince[node.Name] = now
+		log.Warnf("Node %s transitioned to %s: %s", node.Name, condition.Status, condition.Reason)
+	default:
+		return
+	}
+	n.flaps = append(n.flaps, nodeFlap{
+		Timestamp:  now,
+		UUID:       n.Uuid,
+		JobName:    n.JobConfig.Name,
+		MetricName: nodeFlapsMeasurement,
+		NodeName:   node.Name,
+		Ready:      ready,
+		Status:     string(condition.Status),
+		Reason:     condition.Reason,
+		Metadata:   n.Metadata,
+	})
+}
+
+// start nodeFlaps measurement
+func (n *nodeFlaps) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	n.start = time.Now().UTC()
+	n.notReadySince = map[string]time.Time{}
+	n.notReadyDuration = map[string]time.Duration{}
+	n.flaps = nil
+	n.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient: n.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:       "nodeFlapsWatcher",
+				resource:   "nodes",
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: n.handleNode,
+					UpdateFunc: func(oldObj, newObj any) {
+						n.handleNode(newObj)
+					},
+				},
+			},
+		},
+	)
+	return nil
+}
+
+// collects nodeFlaps measurements triggered in the past
+func (n *nodeFlaps) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to nodeFlaps by design")
+	defer measurementWg.Done()
+}
+
+// Stop summarizes the node flaps observed during the measurement, returning an error when they exceed the configured limits
+func (n *nodeFlaps) Stop() error {
+	n.stopWatchers()
+	now := time.Now().UTC()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var notReadyDuration time.Duration
+	var notReadyNodes []string
+	for node, duration := range n.notReadyDuration {
+		if since, notReady := n.notReadySince[node]; notReady {
+			duration += now.Sub(since)
+		}
+		if duration > 0 {
+			notReadyNodes = append(notReadyNodes, node)
+		}
+		notReadyDuration += duration
+	}
+	sort.Strings(notReadyNodes)
+	var flaps int
+	for _, flap := range n.flaps {
+		if !flap.(nodeFlap).Ready {
+			flaps++
+		}
+	}
+	n.summary = nodeFlapSummary{
+		Timestamp:        n.start,
+		UUID:             n.Uuid,
+		JobName:          n.JobConfig.Name,
+		MetricName:       nodeFlapsSummary,
+		Flaps:            flaps,
+		NotReadyDuration: notReadyDuration.Round(time.Second).Seconds(),
+		NotReadyNodes:    notReadyNodes,
+		Metadata:         n.Metadata,
+	}
+	log.Infof("%s: %d node flaps, nodes NotReady for %v in total", n.JobConfig.Name, flaps, notReadyDuration.Round(time.Second))
+	var errs []error
+	limits := n.Config.NodeFlaps
+	if limits.MaxFlaps != nil && flaps > *limits.MaxFlaps {
+		errs = append(errs, fmt.Errorf("nodeFlaps: %d node flaps, higher than the maximum of %d", flaps, *limits.MaxFlaps))
+	}
+	if limits.MaxNotReadyDuration > 0 && notReadyDuration > limits.MaxNotReadyDuration {
+		errs = append(errs, fmt.Errorf("nodeFlaps: nodes NotReady for %v, higher than the maximum of %v", notReadyDuration.Round(time.Second), limits.MaxNotReadyDuration))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func (n *nodeFlaps) Index(jobName string, indexerList map[string]indexers.Indexer) {
+	metricMap := map[string][]any{
+		nodeFlapsSummary: {n.summary},
+	}
+	if len(n.flaps) > 0 {
+		metricMap[nodeFlapsMeasurement] = n.flaps
+	}
+	n.indexLatencyMeasurement(jobName, metricMap, indexerList)
+}
@@ -0,0 +1,317 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	pdbEvictionLatencyMeasurement          = "pdbEvictionLatencyMeasurement"
+	pdbEvictionLatencyQuantilesMeasurement = "pdbEvictionLatencyQuantilesMeasurement"
+	pdbEvictionSummary                     = "pdbEvictionSummary"
+	evictionBlocked                        = "Blocked"
+)
+
+var (
+	supportedPDBEvictionConditions = map[string]struct{}{
+		evictionBlocked: {},
+	}
+)
+
+// pdbEvictionMetric holds the time a pod eviction was blocked by its PodDisruptionBudget
+type pdbEvictionMetric struct {
+	// Timestamp time of the first eviction request
+	Timestamp time.Time `json:"timestamp"`
+	// BlockedTime time since the first eviction request until the pod is evicted, in milliseconds
+	BlockedTime  int    `json:"blockedTime"`
+	UUID         string `json:"uuid"`
+	JobName      string `json:"jobName,omitempty"`
+	MetricName   string `json:"metricName"`
+	Namespace    string `json:"namespace"`
+	PodName      string `json:"podName"`
+	PDB          string `json:"pdb"`
+	JobIteration int    `json:"jobIteration"`
+	Replica      int    `json:"replica"`
+	Metadata     any    `json:"metadata,omitempty"`
+}
+
+// pdbEvictionPDBSummary aggregates the blocked time of the pods evicted under a PodDisruptionBudget
+type pdbEvictionPDBSummary struct {
+	Timestamp  time.Time `json:"timestamp"`
+	UUID       string    `json:"uuid"`
+	JobName    string    `json:"jobName,omitempty"`
+	MetricName string    `json:"metricName"`
+	Namespace  string    `json:"namespace"`
+	PDB        string    `json:"pdb"`
+	Pods       int       `json:"pods"`
+	// TotalBlockedTime and MaxBlockedTime sum and worst case of the blocked time of the pods, in milliseconds
+	TotalBlockedTime int `json:"totalBlockedTime"`
+	MaxBlockedTime   int `json:"maxBlockedTime"`
+	Metadata         any `json:"metadata,omitempty"`
+}
+
+type pdbEvictionLatency struct {
+	BaseMeasurement
+	mu sync.Mutex
+	// pdbs PodDisruptionBudgets indexed by namespace and name
+	pdbs map[string]map[string]labels.Selector
+	// evictions evicted pods indexed by UID
+	evictions map[string]pdbEvictionMetric
+	summaries []any
+}
+
+type pdbEvictionLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newPDBEvictionLatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedPDBEvictionConditions); err != nil {
+		return nil, err
+	}
+	return pdbEvictionLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (pelmf pdbEvictionLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &pdbEvictionLatency{
+		BaseMeasurement: pelmf.NewBaseLatency(jobConfig, clientSet, restConfig, pdbEvictionLatencyMeasurement, pdbEvictionLatencyQuantilesMeasurement, embedCfg),
+	}
+}
+
+// handlePDB records the pod selector of the PodDisruptionBudget
+func (p *pdbEvictionLatency) handlePDB(obj any) {
+	pdb := obj.(*policyv1.PodDisruptionBudget)
+	selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil {
+		log.Errorf("Invalid selector of PodDisruptionBudget %s/%s: %v", pdb.Namespace, pdb.Name, err)
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.pdbs[pdb.Namespace] == nil {
+		p.pdbs[pdb.Namespace] = map[string]labels.Selector{}
+	}
+	p.pdbs[pdb.Namespace][pdb.Name] = selector
+}
+
+// handleDeletePDB forgets the PodDisruptionBudget
+func (p *pdbEvictionLatency) handleDeletePDB(obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	pdb, ok := obj.(*policyv1.PodDisruptionBudget)
+	if !ok {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.pdbs[pdb.Namespace], pdb.Name)
+}
+
+// handlePod records the pods evicted by a delete job once their deletion starts, the ones not covered by a PodDisruptionBudget
+// aren't accounted
+func (p *pdbEvictionLatency) handlePod(obj any, deleted bool) {
+	pod := obj.(*corev1.Pod)
+	requested, exists := pod.Annotations[config.KubeBurnerAnnotationEvictionRequested]
+	if !exists || (pod.DeletionTimestamp == nil && !deleted) {
+		return
+	}
+	now := time.Now().UTC()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, recorded := p.evictions[string(pod.UID)]; recorded {
+		return
+	}
+	requestedTime, err := time.Parse(time.RFC3339Nano, requested)
+	if err != nil {
+		log.Errorf("Invalid eviction request time of pod %s/%s: %v", pod.Namespace, pod.Name, err)
+		return
+	}
+	pdb := p.podPDB(pod)
+	if pdb == "" {
+		log.Debugf("Pod %s/%s isn't covered by any PodDisruptionBudget", pod.Namespace, pod.Name)
+		return
+	}
+	p.evictions[string(pod.UID)] = pdbEvictionMetric{
+		Timestamp:    requestedTime,
+		BlockedTime:  int(now.Sub(requestedTime).Milliseconds()),
+		Namespace:    pod.Namespace,
+		PodName:      pod.Name,
+		PDB:          pdb,
+		JobIteration: getIntFromLabels(pod.Labels, config.KubeBurnerLabelJobIteration),
+		Replica:      getIntFromLabels(pod.Labels, config.KubeBurnerLabelReplica),
+	}
+}
+
+// podPDB returns the name of the PodDisruptionBudget covering the pod, the first one by name when there are several
+func (p *pdbEvictionLatency) podPDB(pod *corev1.Pod) string {
+	var names []string
+	for name, selector := range p.pdbs[pod.Namespace] {
+		if selector.Matches(labels.Set(pod.Labels)) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	return slices.Min(names)
+}
+
+// start pdbEvictionLatency measurement
+func (p *pdbEvictionLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	p.pdbs = map[string]map[string]labels.Selector{}
+	p.evictions = map[string]pdbEvictionMetric{}
+	p.summaries = nil
+	p.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient: p.ClientSet.PolicyV1().RESTClient().(*rest.RESTClient),
+				name:       "pdbWatcher",
+				resource:   "poddisruptionbudgets",
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: p.handlePDB,
+					UpdateFunc: func(oldObj, newObj any) {
+						p.handlePDB(newObj)
+					},
+					DeleteFunc: p.handleDeletePDB,
+				},
+			},
+			{
+				restClient:    p.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "podWatcher",
+				resource:      "pods",
+				labelSelector: p.objectSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					UpdateFunc: func(oldObj, newObj any) {
+						p.handlePod(newObj, false)
+					},
+					DeleteFunc: func(obj any) {
+						if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+							obj = tombstone.Obj
+						}
+						if _, ok := obj.(*corev1.Pod); ok {
+							p.handlePod(obj, true)
+						}
+					},
+				},
+			},
+		},
+	)
+	return nil
+}
+
+// collects pdbEvictionLatency measurements triggered in the past
+func (p *pdbEvictionLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to pdbEvictionLatency by design")
+	defer measurementWg.Done()
+}
+
+// stop pdbEvictionLatency measurement
+func (p *pdbEvictionLatency) Stop() error {
+	return p.StopMeasurement(p.normalizeMetrics, p.getLatency)
+}
+
+// normalizeMetrics generates a document for each evicted pod, and a summary per PodDisruptionBudget
+func (p *pdbEvictionLatency) normalizeMetrics() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	type pdbKey struct {
+		namespace string
+		name      string
+	}
+	summaries := map[pdbKey]*pdbEvictionPDBSummary{}
+	var totalBlockedTime int
+	for _, m := range p.evictions {
+		m.UUID = p.Uuid
+		m.JobName = p.JobConfig.Name
+		m.MetricName = pdbEvictionLatencyMeasurement
+		m.Metadata = p.Metadata
+		p.normLatencies = append(p.normLatencies, m)
+		totalBlockedTime += m.BlockedTime
+		key := pdbKey{m.Namespace, m.PDB}
+		summary, exists := summaries[key]
+		if !exists {
+			summary = &pdbEvictionPDBSummary{
+				Timestamp:  m.Timestamp,
+				UUID:       p.Uuid,
+				JobName:    p.JobConfig.Name,
+				MetricName: pdbEvictionSummary,
+				Namespace:  m.Namespace,
+				PDB:        m.PDB,
+				Metadata:   p.Metadata,
+			}
+			summaries[key] = summary
+		}
+		if m.Timestamp.Before(summary.Timestamp) {
+			summary.Timestamp = m.Timestamp
+		}
+		summary.Pods++
+		summary.TotalBlockedTime += m.BlockedTime
+		summary.MaxBlockedTime = max(summary.MaxBlockedTime, m.BlockedTime)
+	}
+	keys := make([]pdbKey, 0, len(summaries))
+	for key := range summaries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].namespace != keys[j].namespace {
+			return keys[i].namespace < keys[j].namespace
+		}
+		return keys[i].name < keys[j].name
+	})
+	for _, key := range keys {
+		summary := summaries[key]
+		log.Infof("%s: PodDisruptionBudget %s/%s blocked %d evictions for %v, worst case: %v", p.JobConfig.Name, key.namespace, key.name, summary.Pods,
+			time.Duration(summary.TotalBlockedTime)*time.Millisecond, time.Duration(summary.MaxBlockedTime)*time.Millisecond)
+		p.summaries = append(p.summaries, *summary)
+	}
+	log.Infof("%s: %d pods evicted under %d PodDisruptionBudgets, total blocked time: %v", p.JobConfig.Name, len(p.evictions), len(summaries), time.Duration(totalBlockedTime)*time.Millisecond)
+	return 0
+}
+
+func (p *pdbEvictionLatency) getLatency(normLatency any) map[string]float64 {
+	m := normLatency.(pdbEvictionMetric)
+	return map[string]float64{
+		evictionBlocked: float64(m.BlockedTime),
+	}
+}
+
+func (p *pdbEvictionLatency) Index(jobName string, indexerList map[string]indexers.Indexer) {
+	metricMap := map[string][]any{
+		pdbEvictionLatencyMeasurement:          p.rawLatencies(),
+		pdbEvictionLatencyQuantilesMeasurement: p.latencyQuantiles,
+		pdbEvictionSummary:                     p.summaries,
+	}
+	p.indexLatencyMeasurement(jobName, metricMap, indexerList)
+}
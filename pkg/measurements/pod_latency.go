@@ -16,12 +16,14 @@ package measurements
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
 	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/metrics"
 	"github.com/kube-burner/kube-burner/pkg/measurements/types"
 	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
 	log "github.com/sirupsen/logrus"
@@ -34,8 +36,9 @@ import (
 )
 
 const (
-	podLatencyMeasurement          = "podLatencyMeasurement"
-	podLatencyQuantilesMeasurement = "podLatencyQuantilesMeasurement"
+	podLatencyMeasurement                   = "podLatencyMeasurement"
+	podLatencyQuantilesMeasurement          = "podLatencyQuantilesMeasurement"
+	podLatencyNamespaceQuantilesMeasurement = "podLatencyNamespaceQuantilesMeasurement"
 )
 
 var (
@@ -70,8 +73,34 @@ type podMetric struct {
 	Metadata                      any    `json:"metadata,omitempty"`
 }
 
+// namespaceLatencyQuantiles latency quantiles of the pods from a single namespace
+type namespaceLatencyQuantiles struct {
+	metrics.LatencyQuantiles
+	Namespace string `json:"namespace"`
+	// NamespaceIndex ordinal of the namespace within the job
+	NamespaceIndex int `json:"namespaceIndex"`
+}
+
+// MarshalJSON adds the namespace fields to the encoded quantiles, otherwise LatencyQuantiles.MarshalJSON would be promoted
+func (nq namespaceLatencyQuantiles) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(nq.LatencyQuantiles)
+	if err != nil {
+		return nil, err
+	}
+	namespaceFields, err := json.Marshal(struct {
+		Namespace      string `json:"namespace"`
+		NamespaceIndex int    `json:"namespaceIndex"`
+	}{nq.Namespace, nq.NamespaceIndex})
+	if err != nil {
+		return nil, err
+	}
+	// Merge both objects keeping the order of the quantile fields
+	return append(append(j[:len(j)-1], ','), namespaceFields[1:]...), nil
+}
+
 type podLatency struct {
 	BaseMeasurement
+	namespaceQuantiles []any
 }
 
 type podLatencyMeasurementFactory struct {
@@ -107,6 +136,8 @@ func (p *podLatency) handleCreatePod(obj any) {
 		JobIteration: getIntFromLabels(podLabels, config.KubeBurnerLabelJobIteration),
 		Replica:      getIntFromLabels(podLabels, config.KubeBurnerLabelReplica),
 	})
+	// Pods created before the measurement started, i.e. when resuming a run, may already have their conditions set
+	p.handleUpdatePod(pod)
 }
 
 func (p *podLatency) handleUpdatePod(obj any) {
@@ -155,7 +186,7 @@ func (p *podLatency) Start(measurementWg *sync.WaitGroup) error {
 				restClient:    p.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
 				name:          "podWatcher",
 				resource:      "pods",
-				labelSelector: fmt.Sprintf("kube-burner-runid=%v", p.Runid),
+				labelSelector: p.objectSelector(),
 				handlers: &cache.ResourceEventHandlerFuncs{
 					AddFunc: p.handleCreatePod,
 					UpdateFunc: func(oldObj, newObj any) {
@@ -217,7 +248,57 @@ func (p *podLatency) Collect(measurementWg *sync.WaitGroup) {
 
 // Stop stops podLatency measurement
 func (p *podLatency) Stop() error {
-	return p.StopMeasurement(p.normalizeMetrics, p.getLatency)
+	err := p.StopMeasurement(p.normalizeMetrics, p.getLatency)
+	p.namespaceQuantiles = nil
+	if p.Config.NamespaceQuantiles {
+		p.calculateNamespaceQuantiles()
+	}
+	return err
+}
+
+// Index sends metrics to the configured indexers, including the per namespace quantiles when enabled
+func (p *podLatency) Index(jobName string, indexerList map[string]indexers.Indexer) {
+	metricMap := map[string][]any{
+		p.MeasurementName:          p.rawLatencies(),
+		p.QuantilesMeasurementName: p.latencyQuantiles,
+	}
+	if p.Config.NamespaceQuantiles {
+		metricMap[podLatencyNamespaceQuantilesMeasurement] = p.namespaceQuantiles
+	}
+	p.indexLatencyMeasurement(jobName, metricMap, indexerList)
+}
+
+// calculateNamespaceQuantiles calculates the latency quantiles of each pod condition per namespace
+func (p *podLatency) calculateNamespaceQuantiles() {
+	namespaceLatencies := make(map[string]map[string][]float64)
+	namespaceIndexes := make(map[string]int)
+	for _, normLatency := range p.normLatencies {
+		m := normLatency.(podMetric)
+		if _, ok := namespaceLatencies[m.Namespace]; !ok {
+			namespaceLatencies[m.Namespace] = make(map[string][]float64)
+			namespaceIndexes[m.Namespace] = m.JobIteration
+			if p.JobConfig.IterationsPerNamespace > 0 {
+				namespaceIndexes[m.Namespace] = m.JobIteration / p.JobConfig.IterationsPerNamespace
+			}
+		}
+		for condition, latency := range p.getLatency(m) {
+			namespaceLatencies[m.Namespace][condition] = append(namespaceLatencies[m.Namespace][condition], latency)
+		}
+	}
+	for namespace, conditionLatencies := range namespaceLatencies {
+		for condition, latencies := range conditionLatencies {
+			latencySummary := metrics.NewLatencySummary(latencies, condition, p.Config.Quantiles...)
+			latencySummary.UUID = p.Uuid
+			latencySummary.Metadata = p.Metadata
+			latencySummary.MetricName = podLatencyNamespaceQuantilesMeasurement
+			latencySummary.JobName = p.JobConfig.Name
+			p.namespaceQuantiles = append(p.namespaceQuantiles, namespaceLatencyQuantiles{
+				LatencyQuantiles: latencySummary,
+				Namespace:        namespace,
+				NamespaceIndex:   namespaceIndexes[namespace],
+			})
+		}
+	}
 }
 
 func (p *podLatency) normalizeMetrics() float64 {
@@ -15,7 +15,6 @@
 package measurements
 
 import (
-	"fmt"
 	"sync"
 	"time"
 
@@ -57,6 +56,7 @@ type pvcMetric struct {
 	MetricName     string `json:"metricName"`
 	Size           string `json:"size"`
 	StorageClass   string `json:"storageClass"`
+	Provisioner    string `json:"provisioner"`
 	JobIteration   int    `json:"jobIteration"`
 	Replica        int    `json:"replica"`
 	Metadata       any    `json:"metadata,omitempty"`
@@ -95,6 +95,7 @@ func (p *pvcLatency) handleCreatePVC(obj any) {
 		Namespace:    pvc.Namespace,
 		Name:         pvc.Name,
 		StorageClass: getStorageClassName(*pvc),
+		Provisioner:  getProvisionerName(*pvc),
 		Size:         pvc.Spec.Resources.Requests.Storage().String(),
 		MetricName:   pvcLatencyMeasurement,
 		UUID:         p.Uuid,
@@ -112,6 +113,10 @@ func (p *pvcLatency) handleUpdatePVC(obj any) {
 	if value, exists := p.metrics.Load(string(pvc.UID)); exists {
 		pm := value.(pvcMetric)
 		log.Tracef("handleUpdatePVC: PVC: [%s], Version: [%s], Phase: [%s]", pvc.Name, pvc.ResourceVersion, pvc.Status.Phase)
+		// The provisioner annotation is set by the PV controller after the PVC is created
+		if pm.Provisioner == "" {
+			pm.Provisioner = getProvisionerName(*pvc)
+		}
 		if pm.bound == 0 || pm.lost == 0 {
 			// https://pkg.go.dev/k8s.io/api/core/v1#PersistentVolumeClaimPhase
 			if pvc.Status.Phase == corev1.ClaimPending {
@@ -151,7 +156,7 @@ func (p *pvcLatency) Start(measurementWg *sync.WaitGroup) error {
 				restClient:    p.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
 				name:          "pvcWatcher",
 				resource:      "persistentvolumeclaims",
-				labelSelector: fmt.Sprintf("kube-burner-runid=%v", p.Runid),
+				labelSelector: p.objectSelector(),
 				handlers: &cache.ResourceEventHandlerFuncs{
 					AddFunc: p.handleCreatePVC,
 					UpdateFunc: func(oldObj, newObj any) {
@@ -178,6 +183,14 @@ func getStorageClassName(pvc corev1.PersistentVolumeClaim) string {
 	return "unknown"
 }
 
+// helper to fetch the name of the provisioner in charge of the pvc, empty when not set yet
+func getProvisionerName(pvc corev1.PersistentVolumeClaim) string {
+	if provisioner, ok := pvc.Annotations["volume.kubernetes.io/storage-provisioner"]; ok {
+		return provisioner
+	}
+	return pvc.Annotations["volume.beta.kubernetes.io/storage-provisioner"]
+}
+
 // stop pvc latency measurement
 func (p *pvcLatency) Stop() error {
 	return p.StopMeasurement(p.normalizeMetrics, p.getLatency)
@@ -195,6 +208,9 @@ func (p *pvcLatency) normalizeMetrics() float64 {
 			log.Tracef("PVC %v latency ignored as it did not reach a stable state", m.Name)
 			return true
 		}
+		if m.Provisioner == "" {
+			m.Provisioner = "unknown"
+		}
 		errorFlag := 0
 		m.PendingLatency = int(m.pending - m.Timestamp.UnixMilli())
 		if m.PendingLatency < 0 {
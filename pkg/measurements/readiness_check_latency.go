@@ -0,0 +1,323 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	readinessCheckLatencyMeasurement          = "readinessCheckLatencyMeasurement"
+	readinessCheckLatencyQuantilesMeasurement = "readinessCheckLatencyQuantilesMeasurement"
+	k8sReady                                  = "K8sReady"
+	readinessCheckPassed                      = "ReadinessCheck"
+	defaultReadinessCheckInterval             = time.Second
+	defaultReadinessCheckTimeout              = time.Minute
+	podKind                                   = "Pod"
+	serviceKind                               = "Service"
+)
+
+var (
+	supportedReadinessCheckConditions = map[string]struct{}{
+		k8sReady:             {},
+		readinessCheckPassed: {},
+	}
+)
+
+// readinessCheckMetric holds the Kubernetes-level and the application-level readiness latencies of an object
+type readinessCheckMetric struct {
+	Timestamp time.Time `json:"timestamp"`
+	once      sync.Once
+	k8sReady  time.Time
+	passed    time.Time
+	// K8sReady whether the object was ready from the Kubernetes standpoint, the readiness check is only run against these objects
+	K8sReady        bool `json:"k8sReady"`
+	K8sReadyLatency int  `json:"k8sReadyLatency"`
+	// CheckPassed whether the readiness check succeeded before its timeout
+	CheckPassed           bool   `json:"checkPassed"`
+	ReadinessCheckLatency int    `json:"readinessCheckLatency"`
+	Attempts              int    `json:"attempts"`
+	LastError             string `json:"lastError,omitempty"`
+	UUID                  string `json:"uuid"`
+	JobName               string `json:"jobName,omitempty"`
+	MetricName            string `json:"metricName"`
+	Kind                  string `json:"kind"`
+	Namespace             string `json:"namespace"`
+	Name                  string `json:"name"`
+	JobIteration          int    `json:"jobIteration"`
+	Replica               int    `json:"replica"`
+	Metadata              any    `json:"metadata,omitempty"`
+}
+
+type readinessCheckLatency struct {
+	BaseMeasurement
+	// mu guards the readiness check goroutines from being started once the measurement is stopped
+	mu      sync.Mutex
+	stopped bool
+	checkWg sync.WaitGroup
+}
+
+type readinessCheckLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newReadinessCheckLatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedReadinessCheckConditions); err != nil {
+		return nil, err
+	}
+	check := &measurement.ReadinessCheck
+	if (check.HTTPGet == nil) == (check.TCPSocket == nil) {
+		return nil, fmt.Errorf("readinessCheck requires either httpGet or tcpSocket")
+	}
+	if check.HTTPGet != nil && check.HTTPGet.Port <= 0 || check.TCPSocket != nil && check.TCPSocket.Port <= 0 {
+		return nil, fmt.Errorf("readinessCheck port must be > 0")
+	}
+	switch check.Kind {
+	case "":
+		check.Kind = podKind
+	case podKind, serviceKind:
+	default:
+		return nil, fmt.Errorf("unsupported readinessCheck kind %s, supported are Pod and Service", check.Kind)
+	}
+	if check.Interval < 0 || check.Timeout < 0 {
+		return nil, fmt.Errorf("readinessCheck interval and timeout must be >= 0")
+	}
+	if check.Interval == 0 {
+		check.Interval = defaultReadinessCheckInterval
+	}
+	if check.Timeout == 0 {
+		check.Timeout = defaultReadinessCheckTimeout
+	}
+	return readinessCheckLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (rclmf readinessCheckLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &readinessCheckLatency{
+		BaseMeasurement: rclmf.NewBaseLatency(jobConfig, clientSet, restConfig, readinessCheckLatencyMeasurement, readinessCheckLatencyQuantilesMeasurement, embedCfg),
+	}
+}
+
+// handleObject records the first observation of the object, and starts checking it once it's ready from the Kubernetes standpoint.
+// Services are considered ready as soon as they're created
+func (r *readinessCheckLatency) handleObject(obj any) {
+	var m readinessCheckMetric
+	var ready bool
+	var address string
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		m = readinessCheckMetric{
+			Timestamp:    o.CreationTimestamp.UTC(),
+			Namespace:    o.Namespace,
+			Name:         o.Name,
+			JobIteration: getIntFromLabels(o.Labels, config.KubeBurnerLabelJobIteration),
+			Replica:      getIntFromLabels(o.Labels, config.KubeBurnerLabelReplica),
+		}
+		address = o.Status.PodIP
+		for _, c := range o.Status.Conditions {
+			if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+				ready = true
+			}
+		}
+	case *corev1.Service:
+		m = readinessCheckMetric{
+			Timestamp:    o.CreationTimestamp.UTC(),
+			Namespace:    o.Namespace,
+			Name:         o.Name,
+			JobIteration: getIntFromLabels(o.Labels, config.KubeBurnerLabelJobIteration),
+			Replica:      getIntFromLabels(o.Labels, config.KubeBurnerLabelReplica),
+		}
+		address = o.Spec.ClusterIP
+		ready = true
+	default:
+		return
+	}
+	m.UUID, m.JobName, m.MetricName, m.Kind, m.Metadata = r.Uuid, r.JobConfig.Name, readinessCheckLatencyMeasurement, r.Config.ReadinessCheck.Kind, r.Metadata
+	key := m.Namespace + "/" + m.Name
+	value, _ := r.metrics.LoadOrStore(key, &m)
+	if !ready {
+		return
+	}
+	metric := value.(*readinessCheckMetric)
+	metric.once.Do(func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.stopped {
+			return
+		}
+		metric.k8sReady = time.Now().UTC()
+		metric.K8sReady = true
+		log.Tracef("%s %s is ready, running readiness check", metric.Kind, key)
+		r.checkWg.Add(1)
+		go r.runCheck(metric, address)
+	})
+}
+
+// runCheck runs the readiness check against the object until it succeeds or its timeout is reached
+func (r *readinessCheckLatency) runCheck(m *readinessCheckMetric, address string) {
+	defer r.checkWg.Done()
+	check := r.Config.ReadinessCheck
+	wait.PollUntilContextTimeout(context.TODO(), check.Interval, check.Timeout, true, func(ctx context.Context) (bool, error) {
+		m.Attempts++
+		// Each attempt is bounded by the check interval
+		attemptCtx, cancel := context.WithTimeout(ctx, check.Interval)
+		defer cancel()
+		if err := r.checkObject(attemptCtx, m, address); err != nil {
+			log.Tracef("Readiness check of %s %s/%s failed: %v", m.Kind, m.Namespace, m.Name, err)
+			m.LastError = err.Error()
+			return false, nil
+		}
+		m.passed = time.Now().UTC()
+		m.CheckPassed = true
+		m.LastError = ""
+		return true, nil
+	})
+}
+
+// checkObject performs a single readiness check against the object
+func (r *readinessCheckLatency) checkObject(ctx context.Context, m *readinessCheckMetric, address string) error {
+	check := r.Config.ReadinessCheck
+	if check.TCPSocket != nil {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(address, strconv.Itoa(check.TCPSocket.Port)))
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+	var request rest.ResponseWrapper
+	port := strconv.Itoa(check.HTTPGet.Port)
+	if m.Kind == serviceKind {
+		request = r.ClientSet.CoreV1().Services(m.Namespace).ProxyGet(check.HTTPGet.Scheme, m.Name, port, check.HTTPGet.Path, nil)
+	} else {
+		request = r.ClientSet.CoreV1().Pods(m.Namespace).ProxyGet(check.HTTPGet.Scheme, m.Name, port, check.HTTPGet.Path, nil)
+	}
+	body, err := request.DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(string(body), check.HTTPGet.Body) {
+		return fmt.Errorf("response body doesn't contain %q", check.HTTPGet.Body)
+	}
+	return nil
+}
+
+// start readinessCheckLatency measurement
+func (r *readinessCheckLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	if r.JobConfig.JobType != config.CreationJob {
+		log.Fatalf("Unsupported jobType:%s for readinessCheckLatency metric", r.JobConfig.JobType)
+	}
+	r.stopped = false
+	resource := "pods"
+	if r.Config.ReadinessCheck.Kind == serviceKind {
+		resource = "services"
+	}
+	r.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient:    r.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "readinessCheckWatcher",
+				resource:      resource,
+				labelSelector: r.objectSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: r.handleObject,
+					UpdateFunc: func(oldObj, newObj any) {
+						r.handleObject(newObj)
+					},
+				},
+			},
+		},
+	)
+	return nil
+}
+
+// collects readinessCheckLatency measurements triggered in the past
+func (r *readinessCheckLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to readinessCheckLatency by design")
+	defer measurementWg.Done()
+}
+
+// Stop waits for the running readiness checks to finish and stops the measurement
+func (r *readinessCheckLatency) Stop() error {
+	r.mu.Lock()
+	r.stopped = true
+	r.mu.Unlock()
+	log.Infof("Waiting up to %v for the running readiness checks to finish", r.Config.ReadinessCheck.Timeout)
+	r.checkWg.Wait()
+	return r.StopMeasurement(r.normalizeMetrics, r.getLatency)
+}
+
+// normalizeMetrics calculates the readiness latencies from the object creation, and returns the percentage
+// of objects ready from the Kubernetes standpoint whose readiness check failed
+func (r *readinessCheckLatency) normalizeMetrics() float64 {
+	var k8sReadyObjects, notReadyObjects, failedChecks int
+	r.metrics.Range(func(key, value any) bool {
+		m := value.(*readinessCheckMetric)
+		if !m.K8sReady {
+			notReadyObjects++
+		} else {
+			k8sReadyObjects++
+			m.K8sReadyLatency = max(0, int(m.k8sReady.Sub(m.Timestamp).Milliseconds()))
+			if m.CheckPassed {
+				m.ReadinessCheckLatency = max(0, int(m.passed.Sub(m.Timestamp).Milliseconds()))
+			} else {
+				failedChecks++
+				log.Warnf("Readiness check of %s %s/%s failed after %d attempts: %s", m.Kind, m.Namespace, m.Name, m.Attempts, m.LastError)
+			}
+		}
+		r.normLatencies = append(r.normLatencies, m)
+		return true
+	})
+	if notReadyObjects > 0 {
+		log.Warnf("%d objects weren't ready from the Kubernetes standpoint, the readiness check wasn't run against them", notReadyObjects)
+	}
+	if k8sReadyObjects == 0 {
+		return 0
+	}
+	if failedChecks > 0 {
+		log.Errorf("Readiness check failed for %d out of %d ready objects", failedChecks, k8sReadyObjects)
+	}
+	return float64(failedChecks) / float64(k8sReadyObjects) * 100
+}
+
+func (r *readinessCheckLatency) getLatency(normLatency any) map[string]float64 {
+	m := normLatency.(*readinessCheckMetric)
+	latencies := map[string]float64{}
+	if m.K8sReady {
+		latencies[k8sReady] = float64(m.K8sReadyLatency)
+	}
+	if m.CheckPassed {
+		latencies[readinessCheckPassed] = float64(m.ReadinessCheckLatency)
+	}
+	return latencies
+}
@@ -0,0 +1,270 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	reconciliationLatencyMeasurement          = "reconciliationLatencyMeasurement"
+	reconciliationLatencyQuantilesMeasurement = "reconciliationLatencyQuantilesMeasurement"
+	reconciledCondition                       = "Reconciled"
+	defaultGenerationPath                     = ".metadata.generation"
+	defaultObservedGenerationPath             = ".status.observedGeneration"
+)
+
+var (
+	supportedReconciliationConditions = map[string]struct{}{
+		reconciledCondition: {},
+	}
+)
+
+// reconciliationMetric holds the reconciliation of a generation of an object
+type reconciliationMetric struct {
+	// Timestamp time the generation was first seen
+	Timestamp  time.Time `json:"timestamp"`
+	generation int64
+	reconciled time.Time
+	// ReconciliationLatency time from the generation being first seen until the controller observed it, in milliseconds
+	ReconciliationLatency int    `json:"reconciliationLatency"`
+	Generation            int64  `json:"generation"`
+	MetricName            string `json:"metricName"`
+	UUID                  string `json:"uuid"`
+	JobName               string `json:"jobName,omitempty"`
+	JobIteration          int    `json:"jobIteration"`
+	Replica               int    `json:"replica"`
+	Namespace             string `json:"namespace,omitempty"`
+	Name                  string `json:"name"`
+	Kind                  string `json:"kind"`
+	Metadata              any    `json:"metadata,omitempty"`
+}
+
+type reconciliationLatency struct {
+	BaseMeasurement
+	mu                     sync.Mutex
+	generationPath         *jsonpath.JSONPath
+	observedGenerationPath *jsonpath.JSONPath
+	// generations reconciliation of the generations of the objects, indexed by the object UID
+	generations map[string][]*reconciliationMetric
+}
+
+type reconciliationLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newReconciliationLatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedReconciliationConditions); err != nil {
+		return nil, err
+	}
+	if measurement.Reconciliation.APIVersion == "" || measurement.Reconciliation.Kind == "" {
+		return nil, fmt.Errorf("reconciliation apiVersion and kind are required")
+	}
+	if _, err := schema.ParseGroupVersion(measurement.Reconciliation.APIVersion); err != nil {
+		return nil, fmt.Errorf("invalid reconciliation apiVersion %s: %v", measurement.Reconciliation.APIVersion, err)
+	}
+	if measurement.Reconciliation.GenerationPath == "" {
+		measurement.Reconciliation.GenerationPath = defaultGenerationPath
+	}
+	if measurement.Reconciliation.ObservedGenerationPath == "" {
+		measurement.Reconciliation.ObservedGenerationPath = defaultObservedGenerationPath
+	}
+	for _, path := range []string{measurement.Reconciliation.GenerationPath, measurement.Reconciliation.ObservedGenerationPath} {
+		if _, err := parseGenerationPath(path); err != nil {
+			return nil, fmt.Errorf("invalid reconciliation path %s: %v", path, err)
+		}
+	}
+	return reconciliationLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (rlmf reconciliationLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	generationPath, _ := parseGenerationPath(rlmf.Config.Reconciliation.GenerationPath)
+	observedGenerationPath, _ := parseGenerationPath(rlmf.Config.Reconciliation.ObservedGenerationPath)
+	return &reconciliationLatency{
+		BaseMeasurement:        rlmf.NewBaseLatency(jobConfig, clientSet, restConfig, reconciliationLatencyMeasurement, reconciliationLatencyQuantilesMeasurement, embedCfg),
+		generationPath:         generationPath,
+		observedGenerationPath: observedGenerationPath,
+	}
+}
+
+// parseGenerationPath parses a JSONPath expression, the braces are optional
+func parseGenerationPath(path string) (*jsonpath.JSONPath, error) {
+	if !strings.HasPrefix(path, "{") {
+		path = fmt.Sprintf("{%s}", path)
+	}
+	jp := jsonpath.New("generation").AllowMissingKeys(true)
+	return jp, jp.Parse(path)
+}
+
+// generationFromPath returns the integer found at the given path of the object, false when not found
+func generationFromPath(obj *unstructured.Unstructured, jp *jsonpath.JSONPath) (int64, bool) {
+	results, err := jp.FindResults(obj.UnstructuredContent())
+	if err != nil || len(results) == 0 || len(results[0]) == 0 {
+		return 0, false
+	}
+	switch value := results[0][0].Interface().(type) {
+	case int64:
+		return value, true
+	case float64:
+		return int64(value), true
+	case string:
+		generation, err := strconv.ParseInt(value, 10, 64)
+		return generation, err == nil
+	}
+	return 0, false
+}
+
+// handleObject records the time each generation of the object is first seen, and the time it's first observed by its controller
+func (r *reconciliationLatency) handleObject(obj any) {
+	now := time.Now().UTC()
+	object, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	generation, ok := generationFromPath(object, r.generationPath)
+	if !ok {
+		log.Tracef("Generation of %s %s/%s not found", object.GetKind(), object.GetNamespace(), object.GetName())
+		return
+	}
+	observedGeneration, observed := generationFromPath(object, r.observedGenerationPath)
+	uid := string(object.GetUID())
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	generations := r.generations[uid]
+	if len(generations) == 0 || generations[len(generations)-1].generation < generation {
+		objLabels := object.GetLabels()
+		generations = append(generations, &reconciliationMetric{
+			Timestamp:    now,
+			generation:   generation,
+			Namespace:    object.GetNamespace(),
+			Name:         object.GetName(),
+			Kind:         object.GetKind(),
+			JobIteration: getIntFromLabels(objLabels, config.KubeBurnerLabelJobIteration),
+			Replica:      getIntFromLabels(objLabels, config.KubeBurnerLabelReplica),
+		})
+		r.generations[uid] = generations
+	}
+	if !observed {
+		return
+	}
+	// An observed generation reconciles all the previous ones
+	for _, m := range generations {
+		if m.reconciled.IsZero() && m.generation <= observedGeneration {
+			m.reconciled = now
+		}
+	}
+}
+
+// start reconciliationLatency measurement
+func (r *reconciliationLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	r.generations = map[string][]*reconciliationMetric{}
+	gv, _ := schema.ParseGroupVersion(r.Config.Reconciliation.APIVersion)
+	apiGroupResources, err := restmapper.GetAPIGroupResources(r.ClientSet.Discovery())
+	if err != nil {
+		log.Errorf("Error discovering API resources: %v", err)
+		return err
+	}
+	mapping, err := restmapper.NewDiscoveryRESTMapper(apiGroupResources).RESTMapping(gv.WithKind(r.Config.Reconciliation.Kind).GroupKind(), gv.Version)
+	if err != nil {
+		log.Errorf("Error mapping %s %s: %v", r.Config.Reconciliation.APIVersion, r.Config.Reconciliation.Kind, err)
+		return err
+	}
+	restClient, err := getUnstructuredClient(r.RestConfig, gv)
+	if err != nil {
+		log.Errorf("Error creating %s client: %v", mapping.Resource.Resource, err)
+		return err
+	}
+	r.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient:    restClient,
+				name:          "reconciliationWatcher",
+				resource:      mapping.Resource.Resource,
+				labelSelector: r.objectSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: r.handleObject,
+					UpdateFunc: func(oldObj, newObj any) {
+						r.handleObject(newObj)
+					},
+				},
+			},
+		},
+	)
+	return nil
+}
+
+// collects reconciliationLatency measurements triggered in the past
+func (r *reconciliationLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to reconciliationLatency by design")
+	defer measurementWg.Done()
+}
+
+// Stop stops reconciliationLatency measurement
+func (r *reconciliationLatency) Stop() error {
+	return r.StopMeasurement(r.normalizeMetrics, r.getLatency)
+}
+
+// normalizeMetrics generates a document for each reconciled generation
+func (r *reconciliationLatency) normalizeMetrics() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unreconciled int
+	for _, generations := range r.generations {
+		for _, m := range generations {
+			if m.reconciled.IsZero() {
+				log.Tracef("%s %s/%s generation %d latency ignored as it wasn't reconciled", m.Kind, m.Namespace, m.Name, m.generation)
+				unreconciled++
+				continue
+			}
+			m.ReconciliationLatency = int(m.reconciled.Sub(m.Timestamp).Milliseconds())
+			m.Generation = m.generation
+			m.MetricName = reconciliationLatencyMeasurement
+			m.UUID = r.Uuid
+			m.JobName = r.JobConfig.Name
+			m.Metadata = r.Metadata
+			r.normLatencies = append(r.normLatencies, *m)
+		}
+	}
+	if unreconciled > 0 {
+		log.Warnf("%s: %d %s generations weren't reconciled", r.JobConfig.Name, unreconciled, r.Config.Reconciliation.Kind)
+	}
+	return 0
+}
+
+func (r *reconciliationLatency) getLatency(normLatency any) map[string]float64 {
+	m := normLatency.(reconciliationMetric)
+	return map[string]float64{
+		reconciledCondition: float64(m.ReconciliationLatency),
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"runtime/metrics"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	selfMetricsMeasurement     = "selfMetrics"
+	defaultSelfMetricsInterval = 10 * time.Second
+	// cpuSecondsMetric is GOMAXPROCS integrated over the wall-clock time, the CPU used by the process is the total minus the idle time
+	cpuSecondsMetric     = "/cpu/classes/total:cpu-seconds"
+	cpuIdleSecondsMetric = "/cpu/classes/idle:cpu-seconds"
+	heapBytesMetric      = "/memory/classes/heap/objects:bytes"
+	goroutinesMetric     = "/sched/goroutines:goroutines"
+)
+
+// selfMetric resource usage sample of the kube-burner process
+type selfMetric struct {
+	Timestamp  time.Time `json:"timestamp"`
+	UUID       string    `json:"uuid"`
+	JobName    string    `json:"jobName,omitempty"`
+	MetricName string    `json:"metricName"`
+	// CPUUsage CPU cores used since the previous sample
+	CPUUsage float64 `json:"cpuUsage"`
+	// RSS resident set size in bytes, only available in Linux
+	RSS        uint64 `json:"rss,omitempty"`
+	HeapBytes  uint64 `json:"heapBytes"`
+	Goroutines uint64 `json:"goroutines"`
+	Metadata   any    `json:"metadata,omitempty"`
+}
+
+type selfMetricsCollector struct {
+	BaseMeasurement
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+	samples []any
+}
+
+type selfMetricsMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newSelfMetricsMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if measurement.SelfMetricsInterval < 0 {
+		return nil, fmt.Errorf("selfMetricsInterval must be >= 0")
+	}
+	if measurement.SelfMetricsInterval == 0 {
+		measurement.SelfMetricsInterval = defaultSelfMetricsInterval
+	}
+	return selfMetricsMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (smmf selfMetricsMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &selfMetricsCollector{
+		BaseMeasurement: smmf.NewBaseLatency(jobConfig, clientSet, restConfig, selfMetricsMeasurement, "", embedCfg),
+	}
+}
+
+// start selfMetrics measurement, the process resource usage is sampled until the measurement is stopped
+func (s *selfMetricsCollector) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	s.samples = nil
+	s.stopCh, s.doneCh = make(chan struct{}), make(chan struct{})
+	log.Infof("Collecting kube-burner resource usage every %v", s.Config.SelfMetricsInterval)
+	go s.collect()
+	return nil
+}
+
+func (s *selfMetricsCollector) collect() {
+	defer close(s.doneCh)
+	samples := []metrics.Sample{{Name: cpuSecondsMetric}, {Name: cpuIdleSecondsMetric}, {Name: heapBytesMetric}, {Name: goroutinesMetric}}
+	metrics.Read(samples)
+	lastCPU, lastSample := usedCPUSeconds(samples), time.Now()
+	ticker := time.NewTicker(s.Config.SelfMetricsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case now := <-ticker.C:
+			metrics.Read(samples)
+			cpu := usedCPUSeconds(samples)
+			s.samples = append(s.samples, selfMetric{
+				Timestamp:  now.UTC(),
+				UUID:       s.Uuid,
+				JobName:    s.JobConfig.Name,
+				MetricName: selfMetricsMeasurement,
+				CPUUsage:   cpuUsage(lastCPU, cpu, now.Sub(lastSample)),
+				RSS:        readRSS(),
+				HeapBytes:  samples[2].Value.Uint64(),
+				Goroutines: samples[3].Value.Uint64(),
+				Metadata:   s.Metadata,
+			})
+			lastCPU, lastSample = cpu, now
+		}
+	}
+}
+
+// usedCPUSeconds returns the CPU seconds used by the process from the total and idle CPU time samples
+func usedCPUSeconds(samples []metrics.Sample) float64 {
+	return samples[0].Value.Float64() - samples[1].Value.Float64()
+}
+
+// cpuUsage returns the CPU cores used in the given period from the used CPU seconds at its start and end
+func cpuUsage(startCPU, endCPU float64, period time.Duration) float64 {
+	if period <= 0 {
+		return 0
+	}
+	return max(0, endCPU-startCPU) / period.Seconds()
+}
+
+// readRSS returns the resident set size of the process, 0 when /proc is not available
+func readRSS() uint64 {
+	statm, err := os.ReadFile("/proc/self/statm")
+	if err != nil {
+		return 0
+	}
+	fields := bytes.Fields(statm)
+	if len(fields) < 2 {
+		return 0
+	}
+	residentPages, err := strconv.ParseUint(string(fields[1]), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return residentPages * uint64(os.Getpagesize())
+}
+
+// collects selfMetrics measurements triggered in the past
+func (s *selfMetricsCollector) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to selfMetrics by design")
+	defer measurementWg.Done()
+}
+
+// Stop stops sampling the process resource usage, logging the peak usage
+func (s *selfMetricsCollector) Stop() error {
+	close(s.stopCh)
+	<-s.doneCh
+	var maxCPU float64
+	var maxRSS, maxGoroutines uint64
+	for _, sample := range s.samples {
+		m := sample.(selfMetric)
+		maxCPU, maxRSS, maxGoroutines = max(maxCPU, m.CPUUsage), max(maxRSS, m.RSS), max(maxGoroutines, m.Goroutines)
+	}
+	log.Infof("%s: kube-burner peak usage: CPU %.2f cores, RSS %d MiB, %d goroutines", s.JobConfig.Name, maxCPU, maxRSS/1024/1024, maxGoroutines)
+	return nil
+}
+
+func (s *selfMetricsCollector) Index(jobName string, indexerList map[string]indexers.Indexer) {
+	if len(s.samples) == 0 {
+		return
+	}
+	s.indexLatencyMeasurement(jobName, map[string][]any{selfMetricsMeasurement: s.samples}, indexerList)
+}
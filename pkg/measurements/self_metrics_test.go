@@ -0,0 +1,53 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"runtime/metrics"
+	"testing"
+	"time"
+)
+
+func TestCPUUsage(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    float64
+		end      float64
+		period   time.Duration
+		expected float64
+	}{
+		{name: "one core", start: 10, end: 20, period: 10 * time.Second, expected: 1},
+		{name: "fraction of a core", start: 10, end: 12.5, period: 10 * time.Second, expected: 0.25},
+		{name: "idle", start: 10, end: 10, period: 10 * time.Second, expected: 0},
+		{name: "decreasing estimate", start: 10, end: 9, period: 10 * time.Second, expected: 0},
+		{name: "empty period", start: 10, end: 20, period: 0, expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if usage := cpuUsage(tt.start, tt.end, tt.period); usage != tt.expected {
+				t.Errorf("cpuUsage = %v, expected %v", usage, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUsedCPUSecondsExcludesIdle(t *testing.T) {
+	samples := []metrics.Sample{{Name: cpuSecondsMetric}, {Name: cpuIdleSecondsMetric}}
+	metrics.Read(samples)
+	total, used := samples[0].Value.Float64(), usedCPUSeconds(samples)
+	if used < 0 || used > total {
+		t.Fatalf("used CPU seconds %v out of [0, %v]", used, total)
+	}
+}
@@ -173,7 +173,7 @@ func (s *serviceLatency) Start(measurementWg *sync.WaitGroup) error {
 				restClient:    s.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
 				name:          "svcWatcher",
 				resource:      "services",
-				labelSelector: fmt.Sprintf("kube-burner-runid=%v", s.Runid),
+				labelSelector: s.objectSelector(),
 				handlers: &cache.ResourceEventHandlerFuncs{
 					AddFunc: s.handleCreateSvc,
 				},
@@ -204,7 +204,7 @@ func (s *serviceLatency) Stop() error {
 	for _, q := range s.latencyQuantiles {
 		pq := q.(metrics.LatencyQuantiles)
 		// Divide nanoseconds by 1e6 to get milliseconds
-		log.Infof("%s: %s 99th: %dms max: %dms avg: %dms", s.JobConfig.Name, pq.QuantileName, pq.P99/1e6, pq.Max/1e6, pq.Avg/1e6)
+		log.Infof("%s: %s %s", s.JobConfig.Name, pq.QuantileName, pq.Summary(1e6, "ms"))
 	}
 	return nil
 }
@@ -224,7 +224,7 @@ func (s *serviceLatency) normalizeMetrics() {
 		return true
 	})
 	calcSummary := func(name string, inputLatencies []float64) metrics.LatencyQuantiles {
-		latencySummary := metrics.NewLatencySummary(inputLatencies, name)
+		latencySummary := metrics.NewLatencySummary(inputLatencies, name, s.Config.Quantiles...)
 		latencySummary.UUID = s.Uuid
 		latencySummary.Timestamp = time.Now().UTC()
 		latencySummary.Metadata = s.Metadata
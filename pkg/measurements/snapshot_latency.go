@@ -16,7 +16,6 @@ package measurements
 
 import (
 	"context"
-	"fmt"
 	"strings"
 	"sync"
 	"time"
@@ -126,7 +125,7 @@ func (vsl *volumeSnapshotLatency) Start(measurementWg *sync.WaitGroup) error {
 				restClient:    getGroupVersionClient(vsl.RestConfig, volumesnapshotv1.SchemeGroupVersion, &volumesnapshotv1.VolumeSnapshotList{}, &volumesnapshotv1.VolumeSnapshot{}),
 				name:          "vsWatcher",
 				resource:      "volumesnapshots",
-				labelSelector: fmt.Sprintf("kube-burner-runid=%v", vsl.Runid),
+				labelSelector: vsl.objectSelector(),
 				handlers: &cache.ResourceEventHandlerFuncs{
 					AddFunc: vsl.handleCreateVolumeSnapshot,
 					UpdateFunc: func(oldObj, newObj any) {
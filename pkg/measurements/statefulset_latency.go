@@ -0,0 +1,277 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	log "github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	statefulSetLatencyMeasurement          = "statefulSetLatencyMeasurement"
+	statefulSetLatencyQuantilesMeasurement = "statefulSetLatencyQuantilesMeasurement"
+	statefulSetRolloutComplete             = "RolloutComplete"
+	statefulSetSlowestOrdinalReady         = "SlowestOrdinalReady"
+)
+
+var (
+	supportedStatefulSetConditions = map[string]struct{}{
+		statefulSetRolloutComplete:     {},
+		statefulSetSlowestOrdinalReady: {},
+	}
+)
+
+// ordinalReadiness holds the readiness of the pod of a StatefulSet ordinal
+type ordinalReadiness struct {
+	Ordinal int `json:"ordinal"`
+	created time.Time
+	ready   time.Time
+	// ReadyLatency time from the pod creation until it's ready, in milliseconds
+	ReadyLatency int `json:"readyLatency"`
+	// RolloutLatency time from the StatefulSet creation until the pod is ready, in milliseconds
+	RolloutLatency int `json:"rolloutLatency"`
+}
+
+type statefulSetMetric struct {
+	Timestamp time.Time `json:"timestamp"`
+	replicas  int
+	// RolloutLatency time from the StatefulSet creation until all its ordinals are ready, in milliseconds
+	RolloutLatency int `json:"rolloutLatency"`
+	// SlowestOrdinal ordinal whose pod took the longest to become ready, and its ready latency in milliseconds
+	SlowestOrdinal             int                `json:"slowestOrdinal"`
+	SlowestOrdinalReadyLatency int                `json:"slowestOrdinalReadyLatency"`
+	Ordinals                   []ordinalReadiness `json:"ordinals"`
+	Replicas                   int                `json:"replicas"`
+	MetricName                 string             `json:"metricName"`
+	UUID                       string             `json:"uuid"`
+	JobName                    string             `json:"jobName,omitempty"`
+	JobIteration               int                `json:"jobIteration"`
+	Replica                    int                `json:"replica"`
+	Namespace                  string             `json:"namespace"`
+	Name                       string             `json:"statefulSetName"`
+	Metadata                   any                `json:"metadata,omitempty"`
+}
+
+type statefulSetLatency struct {
+	BaseMeasurement
+	mu sync.Mutex
+	// statefulSets StatefulSets indexed by UID
+	statefulSets map[string]*statefulSetMetric
+	// ordinals readiness of the pods indexed by the UID of their StatefulSet and their ordinal
+	ordinals map[string]map[int]*ordinalReadiness
+}
+
+type statefulSetLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newStatefulSetLatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedStatefulSetConditions); err != nil {
+		return nil, err
+	}
+	return statefulSetLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (sslmf statefulSetLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &statefulSetLatency{
+		BaseMeasurement: sslmf.NewBaseLatency(jobConfig, clientSet, restConfig, statefulSetLatencyMeasurement, statefulSetLatencyQuantilesMeasurement, embedCfg),
+	}
+}
+
+// handleStatefulSet records the StatefulSet and its desired replicas
+func (s *statefulSetLatency) handleStatefulSet(obj any) {
+	sts := obj.(*appsv1.StatefulSet)
+	replicas := 1
+	if sts.Spec.Replicas != nil {
+		replicas = int(*sts.Spec.Replicas)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if m, exists := s.statefulSets[string(sts.UID)]; exists {
+		m.replicas = replicas
+		return
+	}
+	s.statefulSets[string(sts.UID)] = &statefulSetMetric{
+		Timestamp:    sts.CreationTimestamp.UTC(),
+		replicas:     replicas,
+		Namespace:    sts.Namespace,
+		Name:         sts.Name,
+		JobIteration: getIntFromLabels(sts.Labels, config.KubeBurnerLabelJobIteration),
+		Replica:      getIntFromLabels(sts.Labels, config.KubeBurnerLabelReplica),
+	}
+}
+
+// handlePod records the first time the pod of each ordinal is ready
+func (s *statefulSetLatency) handlePod(obj any) {
+	pod := obj.(*corev1.Pod)
+	owner := statefulSetOwner(pod)
+	if owner == "" {
+		return
+	}
+	ordinal, ok := podOrdinal(pod)
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ordinals[owner] == nil {
+		s.ordinals[owner] = map[int]*ordinalReadiness{}
+	}
+	or, exists := s.ordinals[owner][ordinal]
+	// Pods re-created for the same ordinal restart its readiness
+	if !exists || (or.ready.IsZero() && pod.CreationTimestamp.After(or.created)) {
+		or = &ordinalReadiness{Ordinal: ordinal, created: pod.CreationTimestamp.UTC()}
+		s.ordinals[owner][ordinal] = or
+	}
+	if !or.ready.IsZero() {
+		return
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+			or.ready = c.LastTransitionTime.UTC()
+		}
+	}
+}
+
+// statefulSetOwner returns the UID of the StatefulSet controlling the pod, empty when it isn't controlled by one
+func statefulSetOwner(pod *corev1.Pod) string {
+	for _, ref := range pod.OwnerReferences {
+		if ref.Controller != nil && *ref.Controller && ref.Kind == "StatefulSet" {
+			return string(ref.UID)
+		}
+	}
+	return ""
+}
+
+// podOrdinal returns the ordinal of a StatefulSet pod, from its pod-index label or else from its name suffix
+func podOrdinal(pod *corev1.Pod) (int, bool) {
+	index, exists := pod.Labels[appsv1.PodIndexLabel]
+	if !exists {
+		index = pod.Name[strings.LastIndex(pod.Name, "-")+1:]
+	}
+	ordinal, err := strconv.Atoi(index)
+	return ordinal, err == nil
+}
+
+// start statefulSetLatency measurement
+func (s *statefulSetLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	s.statefulSets = map[string]*statefulSetMetric{}
+	s.ordinals = map[string]map[int]*ordinalReadiness{}
+	s.startMeasurement(
+		[]MeasurementWatcher{
+			{
+				restClient:    s.ClientSet.AppsV1().RESTClient().(*rest.RESTClient),
+				name:          "statefulSetWatcher",
+				resource:      "statefulsets",
+				labelSelector: s.objectSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: s.handleStatefulSet,
+					UpdateFunc: func(oldObj, newObj any) {
+						s.handleStatefulSet(newObj)
+					},
+				},
+			},
+			// The labelSelector narrows the StatefulSets, their pods don't necessarily share their labels
+			{
+				restClient:    s.ClientSet.CoreV1().RESTClient().(*rest.RESTClient),
+				name:          "podWatcher",
+				resource:      "pods",
+				labelSelector: s.runSelector(),
+				handlers: &cache.ResourceEventHandlerFuncs{
+					AddFunc: s.handlePod,
+					UpdateFunc: func(oldObj, newObj any) {
+						s.handlePod(newObj)
+					},
+				},
+			},
+		},
+	)
+	return nil
+}
+
+// collects statefulSetLatency measurements triggered in the past
+func (s *statefulSetLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to statefulSetLatency by design")
+	defer measurementWg.Done()
+}
+
+// Stop stops statefulSetLatency measurement
+func (s *statefulSetLatency) Stop() error {
+	return s.StopMeasurement(s.normalizeMetrics, s.getLatency)
+}
+
+// normalizeMetrics generates a document for each StatefulSet whose ordinals all became ready
+func (s *statefulSetLatency) normalizeMetrics() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for uid, m := range s.statefulSets {
+		if m.replicas == 0 {
+			continue
+		}
+		m.Ordinals = nil
+		for ordinal := range m.replicas {
+			or, exists := s.ordinals[uid][ordinal]
+			if !exists || or.ready.IsZero() {
+				log.Tracef("StatefulSet %s/%s latency ignored as ordinal %d didn't become ready", m.Namespace, m.Name, ordinal)
+				m.Ordinals = nil
+				break
+			}
+			or.ReadyLatency = max(0, int(or.ready.Sub(or.created).Milliseconds()))
+			or.RolloutLatency = max(0, int(or.ready.Sub(m.Timestamp).Milliseconds()))
+			m.Ordinals = append(m.Ordinals, *or)
+		}
+		if len(m.Ordinals) == 0 {
+			continue
+		}
+		slowest := slices.MaxFunc(m.Ordinals, func(a, b ordinalReadiness) int {
+			return a.ReadyLatency - b.ReadyLatency
+		})
+		m.RolloutLatency = slices.MaxFunc(m.Ordinals, func(a, b ordinalReadiness) int {
+			return a.RolloutLatency - b.RolloutLatency
+		}).RolloutLatency
+		m.SlowestOrdinal, m.SlowestOrdinalReadyLatency = slowest.Ordinal, slowest.ReadyLatency
+		m.Replicas = m.replicas
+		m.MetricName = statefulSetLatencyMeasurement
+		m.UUID = s.Uuid
+		m.JobName = s.JobConfig.Name
+		m.Metadata = s.Metadata
+		s.normLatencies = append(s.normLatencies, *m)
+	}
+	return 0
+}
+
+func (s *statefulSetLatency) getLatency(normLatency any) map[string]float64 {
+	m := normLatency.(statefulSetMetric)
+	return map[string]float64{
+		statefulSetRolloutComplete:     float64(m.RolloutLatency),
+		statefulSetSlowestOrdinalReady: float64(m.SlowestOrdinalReadyLatency),
+	}
+}
@@ -54,6 +54,164 @@ type Measurement struct {
 	QuantilesIndexer string `yaml:"quantilesIndexer"`
 	// Defines the indexer for timeseries
 	TimeseriesIndexer string `yaml:"timeseriesIndexer"`
+	// NamespaceQuantiles enables indexing latency quantiles per namespace
+	NamespaceQuantiles bool `yaml:"namespaceQuantiles"`
+	// Quantiles list of latency quantiles replacing the default P99, P95 and P50, i.e. [0.5, 0.9, 0.99, 0.999]
+	Quantiles []float64 `yaml:"quantiles"`
+	// RestartThreshold maximum number of container restarts accepted by the containerRestarts measurement, the check is disabled when not set
+	RestartThreshold *int `yaml:"restartThreshold"`
+	// SelfMetricsInterval sampling interval of the selfMetrics measurement
+	SelfMetricsInterval time.Duration `yaml:"selfMetricsInterval"`
+	// ReadinessCheck application-level readiness check of the readinessCheckLatency measurement
+	ReadinessCheck ReadinessCheck `yaml:"readinessCheck"`
+	// ConfigPropagation configuration of the configPropagationLatency measurement
+	ConfigPropagation ConfigPropagation `yaml:"configPropagation"`
+	// HPA autoscalers tracked by the hpaLatency measurement
+	HPA HPA `yaml:"hpa"`
+	// Ingress probing of the ingressLatency measurement
+	Ingress Ingress `yaml:"ingress"`
+	// LeaderElection leases tracked by the leaderElection measurement
+	LeaderElection LeaderElection `yaml:"leaderElection"`
+	// NodeFlaps failure thresholds of the nodeFlaps measurement
+	NodeFlaps NodeFlaps `yaml:"nodeFlaps"`
+	// FirstLog configuration of the firstLogLatency measurement
+	FirstLog FirstLog `yaml:"firstLog"`
+	// RawSamples indexes the per-object latency documents to all the indexers, optionally sampled
+	RawSamples *RawSamples `yaml:"rawSamples"`
+	// LabelSelector only the benchmark objects matching this label selector are measured, i.e. app=workload or role!=infra
+	LabelSelector string `yaml:"labelSelector"`
+	// DNS configuration of the DNS probe injected by the dnsLatency measurement
+	DNS DNS `yaml:"dns"`
+	// LogErrors configuration of the logErrors measurement
+	LogErrors LogErrors `yaml:"logErrors"`
+	// Reconciliation custom resources tracked by the reconciliationLatency measurement
+	Reconciliation Reconciliation `yaml:"reconciliation"`
+}
+
+// Reconciliation holds the custom resource whose reconciliation is measured and the paths of its generation fields
+type Reconciliation struct {
+	// APIVersion API version of the custom resource
+	APIVersion string `yaml:"apiVersion"`
+	// Kind kind of the custom resource
+	Kind string `yaml:"kind"`
+	// GenerationPath JSONPath of the generation of the object, .metadata.generation when not set
+	GenerationPath string `yaml:"generationPath"`
+	// ObservedGenerationPath JSONPath of the generation reconciled by the controller, .status.observedGeneration when not set
+	ObservedGenerationPath string `yaml:"observedGenerationPath"`
+}
+
+// LogErrors holds the error patterns looked for in the logs of the pods created by the benchmark
+type LogErrors struct {
+	// Patterns regular expressions matched against the log lines
+	Patterns []string `yaml:"patterns"`
+	// Container container whose logs are read, all the containers of the pod when not set
+	Container string `yaml:"container"`
+	// MaxMatches maximum number of matching lines tolerated, the measurement fails when exceeded
+	MaxMatches int `yaml:"maxMatches"`
+	// MaxLines maximum number of matching lines reported in the summary, 20 when not set
+	MaxLines int `yaml:"maxLines"`
+	// MaxStreams maximum number of pod log streams open at the same time, 50 when not set
+	MaxStreams int `yaml:"maxStreams"`
+}
+
+// DNS holds the lookup run by the DNS probe injected into the pods created by the benchmark
+type DNS struct {
+	// Target hostname resolved by the probe, kubernetes.default.svc when not set
+	Target string `yaml:"target"`
+	// Image image of the probe, quay.io/cloud-bulldozer/fedora-nc:latest when not set
+	Image string `yaml:"image"`
+}
+
+// RawSamples holds the sampling configuration of the per-object latency documents
+type RawSamples struct {
+	// SampleRate fraction of the per-object documents indexed, all of them are indexed when not set
+	SampleRate float64 `yaml:"sampleRate"`
+	// MaxSamples maximum number of per-object documents indexed, unlimited when not set
+	MaxSamples int `yaml:"maxSamples"`
+}
+
+// ConfigPropagation holds how Secret and ConfigMap versions are identified and reported by the pods mounting them
+type ConfigPropagation struct {
+	// VersionKey data key of the Secret or ConfigMap holding its version
+	VersionKey string `yaml:"versionKey"`
+	// Annotation pod annotation where the pod reports the version it reads from the mounted Secret or ConfigMap
+	Annotation string `yaml:"annotation"`
+}
+
+// HPA holds the HorizontalPodAutoscaler tracked by the hpaLatency measurement, the ones created by the benchmark when its name isn't set
+type HPA struct {
+	// Name name of the HorizontalPodAutoscaler
+	Name string `yaml:"name"`
+	// Namespace namespace of the HorizontalPodAutoscaler, required when the name is set
+	Namespace string `yaml:"namespace"`
+}
+
+// Ingress holds whether the ingressLatency measurement probes the addresses of the Ingresses and HTTPRoutes
+type Ingress struct {
+	// Probe sends HTTP requests to the address of each Ingress or HTTPRoute once it's ready, until it's served
+	Probe bool `yaml:"probe"`
+	// ProbeStatus HTTP status code expected from the probes, 200 when not set
+	ProbeStatus int `yaml:"probeStatus"`
+	// ProbeTimeout maximum period each object is probed, 5m when not set
+	ProbeTimeout time.Duration `yaml:"probeTimeout"`
+}
+
+// LeaderElection holds the namespaces of the controller leases tracked by the leaderElection measurement
+type LeaderElection struct {
+	// Namespaces namespaces of the leases, kube-system when not set
+	Namespaces []string `yaml:"namespaces"`
+	// Leases names of the leases tracked, all the leases of the namespaces when not set
+	Leases []string `yaml:"leases"`
+}
+
+// NodeFlaps holds the limits of node readiness flaps tolerated by the nodeFlaps measurement, the measurement fails when exceeded
+type NodeFlaps struct {
+	// MaxFlaps maximum number of Ready to NotReady transitions, not enforced when not set
+	MaxFlaps *int `yaml:"maxFlaps"`
+	// MaxNotReadyDuration maximum time the nodes were NotReady in total, not enforced when not set
+	MaxNotReadyDuration time.Duration `yaml:"maxNotReadyDuration"`
+}
+
+// FirstLog holds how the first meaningful log line of the pods is identified
+type FirstLog struct {
+	// Pattern regular expression matched against the log lines, the first log line is used when not set
+	Pattern string `yaml:"pattern"`
+	// Container container whose logs are read, the first container of the pod when not set
+	Container string `yaml:"container"`
+	// MaxStreams maximum number of pod log streams open at the same time
+	MaxStreams int `yaml:"maxStreams"`
+}
+
+// ReadinessCheck holds the configuration of an application-level readiness check, run against the pods or services created by the job
+type ReadinessCheck struct {
+	// Kind kind of the checked objects, Pod or Service
+	Kind string `yaml:"kind"`
+	// HTTPGet sends HTTP GET requests through the API server proxy
+	HTTPGet *HTTPGetCheck `yaml:"httpGet"`
+	// TCPSocket dials the IP of the object
+	TCPSocket *TCPSocketCheck `yaml:"tcpSocket"`
+	// Interval period between checks
+	Interval time.Duration `yaml:"interval"`
+	// Timeout maximum period an object is checked before accounting the check as failed
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// HTTPGetCheck succeeds when the request returns a 2xx status code and a response body containing the expected body
+type HTTPGetCheck struct {
+	// Scheme http or https
+	Scheme string `yaml:"scheme"`
+	// Port target port
+	Port int `yaml:"port"`
+	// Path request path
+	Path string `yaml:"path"`
+	// Body expected substring of the response body, not verified when empty
+	Body string `yaml:"body"`
+}
+
+// TCPSocketCheck succeeds when a TCP connection is established
+type TCPSocketCheck struct {
+	// Port target port
+	Port int `yaml:"port"`
 }
 
 // LatencyThreshold holds the thresholds configuration
@@ -269,7 +269,7 @@ func (vmi *vmiLatency) Start(measurementWg *sync.WaitGroup) error {
 				restClient:    restClient,
 				name:          "vmWatcher",
 				resource:      "virtualmachines",
-				labelSelector: fmt.Sprintf("kube-burner-runid=%v", vmi.Runid),
+				labelSelector: vmi.objectSelector(),
 				handlers: &cache.ResourceEventHandlerFuncs{
 					AddFunc: vmi.handleCreateVM,
 					UpdateFunc: func(oldObj, newObj any) {
@@ -281,7 +281,7 @@ func (vmi *vmiLatency) Start(measurementWg *sync.WaitGroup) error {
 				restClient:    restClient,
 				name:          "vmiWatcher",
 				resource:      "virtualmachineinstances",
-				labelSelector: fmt.Sprintf("kube-burner-runid=%v", vmi.Runid),
+				labelSelector: vmi.objectSelector(),
 				handlers: &cache.ResourceEventHandlerFuncs{
 					AddFunc: vmi.handleCreateVMI,
 					UpdateFunc: func(oldObj, newObj any) {
@@ -0,0 +1,371 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package measurements
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/measurements/metrics"
+	"github.com/kube-burner/kube-burner/pkg/measurements/types"
+	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	webhookLatencyMeasurement          = "webhookLatencyMeasurement"
+	webhookLatencyQuantilesMeasurement = "webhookLatencyQuantilesMeasurement"
+	apiserverRequestDurationMetric     = "apiserver_request_duration_seconds"
+	webhookAdmissionDurationMetric     = "apiserver_admission_webhook_admission_duration_seconds"
+	createRequestQuantile              = "CreateRequest"
+	webhooksQuantile                   = "Webhooks"
+)
+
+var supportedWebhookConditions = map[string]struct{}{
+	createRequestQuantile: {},
+	webhooksQuantile:      {},
+}
+
+// nonPersistedResources are created through POST requests that are not admitted by webhooks, they are excluded from the request duration
+var nonPersistedResources = map[string]bool{
+	"events":                    true,
+	"tokenreviews":              true,
+	"subjectaccessreviews":      true,
+	"selfsubjectaccessreviews":  true,
+	"localsubjectaccessreviews": true,
+	"selfsubjectrulesreviews":   true,
+	"selfsubjectreviews":        true,
+}
+
+// histogram holds the cumulative buckets of a prometheus histogram
+type histogram struct {
+	count   uint64
+	sum     float64
+	bounds  []float64
+	buckets []uint64
+}
+
+// webhookHistograms admission duration histograms of the CREATE operations, indexed by webhook name and type
+type webhookHistograms map[webhookKey]map[bool]*histogram
+
+type webhookKey struct {
+	name string
+	kind string
+}
+
+// apiserverSnapshot histograms scraped from the apiserver metrics endpoint
+type apiserverSnapshot struct {
+	requests *histogram
+	webhooks webhookHistograms
+}
+
+type webhookMetric struct {
+	Timestamp  time.Time `json:"timestamp"`
+	UUID       string    `json:"uuid"`
+	JobName    string    `json:"jobName,omitempty"`
+	MetricName string    `json:"metricName"`
+	Webhook    string    `json:"webhook"`
+	Type       string    `json:"type"`
+	Operation  string    `json:"operation"`
+	Requests   uint64    `json:"requests"`
+	Rejected   uint64    `json:"rejected"`
+	// AvgLatency and P99Latency in milliseconds
+	AvgLatency int `json:"avgLatency"`
+	P99Latency int `json:"p99Latency"`
+	// TotalDuration time spent in the webhook in milliseconds
+	TotalDuration int `json:"totalDuration"`
+	// RequestShare percentage of the create request time spent in the webhook
+	RequestShare float64 `json:"requestShare"`
+	Metadata     any     `json:"metadata,omitempty"`
+}
+
+type webhookLatency struct {
+	BaseMeasurement
+	start     time.Time
+	startSnap *apiserverSnapshot
+}
+
+type webhookLatencyMeasurementFactory struct {
+	BaseMeasurementFactory
+}
+
+func newWebhookLatencyMeasurementFactory(configSpec config.Spec, measurement types.Measurement, metadata map[string]any) (MeasurementFactory, error) {
+	if err := verifyMeasurementConfig(measurement, supportedWebhookConditions); err != nil {
+		return nil, err
+	}
+	return webhookLatencyMeasurementFactory{
+		BaseMeasurementFactory: NewBaseMeasurementFactory(configSpec, measurement, metadata),
+	}, nil
+}
+
+func (wlmf webhookLatencyMeasurementFactory) NewMeasurement(jobConfig *config.Job, clientSet kubernetes.Interface, restConfig *rest.Config, embedCfg *fileutils.EmbedConfiguration) Measurement {
+	return &webhookLatency{
+		BaseMeasurement: wlmf.NewBaseLatency(jobConfig, clientSet, restConfig, webhookLatencyMeasurement, webhookLatencyQuantilesMeasurement, embedCfg),
+	}
+}
+
+// start webhookLatency measurement, the apiserver histograms are scraped to calculate their increase when the measurement is stopped
+func (w *webhookLatency) Start(measurementWg *sync.WaitGroup) error {
+	defer measurementWg.Done()
+	w.latencyQuantiles, w.normLatencies = nil, nil
+	w.start = time.Now()
+	log.Infof("Scraping apiserver request and admission webhook histograms for %s", w.JobConfig.Name)
+	snapshot, err := w.scrape()
+	if err != nil {
+		return fmt.Errorf("webhookLatency: %v", err)
+	}
+	w.startSnap = snapshot
+	return nil
+}
+
+// collects webhookLatency measurements triggered in the past
+func (w *webhookLatency) Collect(measurementWg *sync.WaitGroup) {
+	log.Info("Collect method doesn't apply to webhookLatency by design")
+	defer measurementWg.Done()
+}
+
+// Stop calculates the create request and the admission webhook latencies observed since the measurement was started
+func (w *webhookLatency) Stop() error {
+	if w.startSnap == nil {
+		return fmt.Errorf("webhookLatency: measurement was not started")
+	}
+	endSnap, err := w.scrape()
+	if err != nil {
+		return fmt.Errorf("webhookLatency: %v", err)
+	}
+	requests := endSnap.requests.sub(w.startSnap.requests)
+	w.latencyQuantiles = append(w.latencyQuantiles, w.histogramQuantiles(createRequestQuantile, requests))
+	allWebhooks := &histogram{}
+	var keys []webhookKey
+	for key := range endSnap.webhooks {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].name < keys[j].name || (keys[i].name == keys[j].name && keys[i].kind < keys[j].kind)
+	})
+	for _, key := range keys {
+		webhook, rejected := &histogram{}, &histogram{}
+		for r, h := range endSnap.webhooks[key] {
+			delta := h.sub(w.startSnap.webhooks[key][r])
+			if r {
+				rejected = rejected.add(delta)
+			}
+			webhook = webhook.add(delta)
+		}
+		if webhook.count == 0 {
+			continue
+		}
+		allWebhooks = allWebhooks.add(webhook)
+		w.latencyQuantiles = append(w.latencyQuantiles, w.histogramQuantiles(key.name, webhook))
+		w.normLatencies = append(w.normLatencies, webhookMetric{
+			Timestamp:     w.start.UTC(),
+			UUID:          w.Uuid,
+			JobName:       w.JobConfig.Name,
+			MetricName:    webhookLatencyMeasurement,
+			Webhook:       key.name,
+			Type:          key.kind,
+			Operation:     "CREATE",
+			Requests:      webhook.count,
+			Rejected:      rejected.count,
+			AvgLatency:    int(webhook.sum / float64(webhook.count) * 1000),
+			P99Latency:    int(webhook.quantile(0.99) * 1000),
+			TotalDuration: int(webhook.sum * 1000),
+			RequestShare:  share(webhook.sum, requests.sum),
+			Metadata:      w.Metadata,
+		})
+	}
+	if allWebhooks.count > 0 {
+		w.latencyQuantiles = append(w.latencyQuantiles, w.histogramQuantiles(webhooksQuantile, allWebhooks))
+	}
+	for _, q := range w.latencyQuantiles {
+		pq := q.(metrics.LatencyQuantiles)
+		log.Infof("%s: %v %s", w.JobConfig.Name, pq.QuantileName, pq.Summary(1, "ms"))
+	}
+	if allWebhooks.count > 0 {
+		log.Infof("%s: admission webhooks accounted for %.2f%% of the create request time (%.2fs out of %.2fs)", w.JobConfig.Name, share(allWebhooks.sum, requests.sum), allWebhooks.sum, requests.sum)
+	} else {
+		log.Infof("%s: no admission webhook calls observed in create requests", w.JobConfig.Name)
+	}
+	if len(w.Config.LatencyThresholds) > 0 {
+		return metrics.CheckThreshold(w.Config.LatencyThresholds, w.latencyQuantiles)
+	}
+	return nil
+}
+
+func (w *webhookLatency) Index(jobName string, indexerList map[string]indexers.Indexer) {
+	if w.startSnap == nil {
+		return
+	}
+	w.BaseMeasurement.Index(jobName, indexerList)
+}
+
+// scrape reads the apiserver request duration and the admission webhook duration histograms of create requests
+func (w *webhookLatency) scrape() (*apiserverSnapshot, error) {
+	raw, err := w.ClientSet.CoreV1().RESTClient().Get().AbsPath("/metrics").DoRaw(context.TODO())
+	if err != nil {
+		return nil, fmt.Errorf("error scraping apiserver metrics: %v", err)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing apiserver metrics: %v", err)
+	}
+	snapshot := &apiserverSnapshot{
+		requests: &histogram{},
+		webhooks: webhookHistograms{},
+	}
+	if family, ok := families[apiserverRequestDurationMetric]; ok {
+		for _, m := range family.GetMetric() {
+			labels := metricLabels(m)
+			if labels["verb"] != "POST" || labels["subresource"] != "" || nonPersistedResources[labels["resource"]] {
+				continue
+			}
+			snapshot.requests = snapshot.requests.add(newHistogram(m))
+		}
+	} else {
+		return nil, fmt.Errorf("metric %s not found in the apiserver metrics", apiserverRequestDurationMetric)
+	}
+	// Admission webhook metrics are only exposed once a webhook has been called
+	for _, m := range families[webhookAdmissionDurationMetric].GetMetric() {
+		labels := metricLabels(m)
+		if labels["operation"] != "CREATE" {
+			continue
+		}
+		key := webhookKey{name: labels["name"], kind: labels["type"]}
+		if snapshot.webhooks[key] == nil {
+			snapshot.webhooks[key] = map[bool]*histogram{}
+		}
+		rejected := labels["rejected"] == "true"
+		snapshot.webhooks[key][rejected] = newHistogram(m).add(snapshot.webhooks[key][rejected])
+	}
+	return snapshot, nil
+}
+
+// histogramQuantiles estimates the latency quantiles in milliseconds of the given histogram
+func (w *webhookLatency) histogramQuantiles(name string, h *histogram) metrics.LatencyQuantiles {
+	lq := metrics.LatencyQuantiles{
+		QuantileName: name,
+		UUID:         w.Uuid,
+		P99:          int(h.quantile(0.99) * 1000),
+		P95:          int(h.quantile(0.95) * 1000),
+		P50:          int(h.quantile(0.50) * 1000),
+		Max:          int(h.quantile(1) * 1000),
+		Timestamp:    time.Now().UTC(),
+		MetricName:   webhookLatencyQuantilesMeasurement,
+		JobName:      w.JobConfig.Name,
+		Metadata:     w.Metadata,
+	}
+	if h.count > 0 {
+		lq.Avg = int(h.sum / float64(h.count) * 1000)
+	}
+	for _, quantile := range w.Config.Quantiles {
+		lq.CustomQuantiles = append(lq.CustomQuantiles, metrics.Quantile{
+			Name:  metrics.QuantileName(quantile),
+			Value: int(h.quantile(quantile) * 1000),
+		})
+	}
+	return lq
+}
+
+func metricLabels(m *dto.Metric) map[string]string {
+	labels := make(map[string]string, len(m.GetLabel()))
+	for _, label := range m.GetLabel() {
+		labels[label.GetName()] = label.GetValue()
+	}
+	return labels
+}
+
+func newHistogram(m *dto.Metric) *histogram {
+	h := &histogram{
+		count: m.GetHistogram().GetSampleCount(),
+		sum:   m.GetHistogram().GetSampleSum(),
+	}
+	for _, bucket := range m.GetHistogram().GetBucket() {
+		h.bounds = append(h.bounds, bucket.GetUpperBound())
+		h.buckets = append(h.buckets, bucket.GetCumulativeCount())
+	}
+	return h
+}
+
+// add returns the sum of both histograms, histograms of the same metric share their bucket bounds
+func (h *histogram) add(other *histogram) *histogram {
+	if other == nil || other.count == 0 {
+		return h
+	}
+	if h.count == 0 {
+		return other
+	}
+	result := &histogram{count: h.count + other.count, sum: h.sum + other.sum, bounds: h.bounds}
+	for i := range h.buckets {
+		result.buckets = append(result.buckets, h.buckets[i]+other.buckets[i])
+	}
+	return result
+}
+
+// sub returns the increase of the histogram since the previous one was scraped, a counter reset means the apiserver
+// was restarted and the whole histogram is returned
+func (h *histogram) sub(previous *histogram) *histogram {
+	if previous == nil || previous.count == 0 || previous.count > h.count || len(previous.buckets) != len(h.buckets) {
+		return h
+	}
+	result := &histogram{count: h.count - previous.count, sum: h.sum - previous.sum, bounds: h.bounds}
+	for i := range h.buckets {
+		result.buckets = append(result.buckets, h.buckets[i]-previous.buckets[i])
+	}
+	return result
+}
+
+// quantile estimates the given quantile in seconds interpolating linearly within the bucket, like prometheus'
+// histogram_quantile does. Observations beyond the highest bucket are reported as its upper bound
+func (h *histogram) quantile(q float64) float64 {
+	if h.count == 0 {
+		return 0
+	}
+	rank := q * float64(h.count)
+	var lowerBound float64
+	var lowerCount uint64
+	for i, bound := range h.bounds {
+		if math.IsInf(bound, 1) {
+			break
+		}
+		if float64(h.buckets[i]) >= rank {
+			bucketCount := h.buckets[i] - lowerCount
+			if bucketCount == 0 {
+				return bound
+			}
+			return lowerBound + (bound-lowerBound)*(rank-float64(lowerCount))/float64(bucketCount)
+		}
+		lowerBound, lowerCount = bound, h.buckets[i]
+	}
+	return lowerBound
+}
+
+func share(part, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(part/total*10000) / 100
+}
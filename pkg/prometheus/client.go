@@ -0,0 +1,103 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	api "github.com/prometheus/client_golang/api"
+	apiv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+// authTransport sets the auth and the custom headers in every request, headers are
+// required by multi-tenant stores like Cortex or Mimir, i.e. X-Scope-OrgID
+type authTransport struct {
+	http.RoundTripper
+	auth Auth
+}
+
+func (t authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the original request
+	req = req.Clone(req.Context())
+	for k, v := range t.auth.Headers {
+		req.Header.Set(k, v)
+	}
+	if t.auth.Username != "" {
+		req.SetBasicAuth(t.auth.Username, t.auth.Password)
+	}
+	if t.auth.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.auth.Token)
+	}
+	return t.RoundTripper.RoundTrip(req)
+}
+
+// newAPI returns a prometheus API client using the same transport as the go-commons client, plus the custom headers
+func newAPI(url string, auth Auth) (apiv1.API, error) {
+	c, err := api.NewClient(api.Config{
+		Address: url,
+		RoundTripper: authTransport{
+			RoundTripper: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: &tls.Config{InsecureSkipVerify: auth.SkipTLSVerify}},
+			auth:         auth,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiv1.NewAPI(c), nil
+}
+
+// verifyConnection runs a query to verify the prometheus connection
+func verifyConnection(ctx context.Context, promAPI apiv1.API) error {
+	_, _, err := promAPI.Query(ctx, "up{}", time.Now().UTC())
+	return err
+}
+
+// Query runs an instant query, bounded by QueryTimeout
+func (p *Prometheus) Query(query string, time time.Time) (model.Value, error) {
+	ctx, cancel := p.queryContext()
+	defer cancel()
+	v, _, err := p.api.Query(ctx, query, time)
+	return v, p.queryError(ctx, err)
+}
+
+// QueryRange runs a range query, bounded by QueryTimeout
+func (p *Prometheus) QueryRange(query string, start, end time.Time, step time.Duration) (model.Value, error) {
+	ctx, cancel := p.queryContext()
+	defer cancel()
+	v, _, err := p.api.QueryRange(ctx, query, apiv1.Range{Start: start, End: end, Step: step})
+	return v, p.queryError(ctx, err)
+}
+
+// queryContext returns the context of a query, with a QueryTimeout deadline unless it's 0
+func (p *Prometheus) queryContext() (context.Context, context.CancelFunc) {
+	if p.QueryTimeout == 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), p.QueryTimeout)
+}
+
+// queryError returns a timeout error when the query deadline was exceeded
+func (p *Prometheus) queryError(ctx context.Context, err error) error {
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("query timed out after %v", p.QueryTimeout)
+	}
+	return err
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kube-burner/kube-burner/pkg/config"
+)
+
+func TestNewPrometheusClientHeaders(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("X-Scope-OrgID") != "perf" {
+			t.Errorf("expected X-Scope-OrgID header, got %q", r.Header.Get("X-Scope-OrgID"))
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("expected bearer auth, got %q", r.Header.Get("Authorization"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[]}}`))
+	}))
+	defer server.Close()
+	auth := Auth{Token: "token", Headers: map[string]string{"X-Scope-OrgID": "perf"}}
+	p, err := NewPrometheusClient(config.Spec{}, server.URL, auth, time.Second, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := p.Query("up", time.Now()); err != nil {
+		t.Fatalf("unexpected query error: %v", err)
+	}
+	// Connection check plus query
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestQueryTimeout(t *testing.T) {
+	canceled := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The connection close is only detected once the body is read
+		io.Copy(io.Discard, r.Body)
+		// Blocks until the client gives up on the request
+		<-r.Context().Done()
+		close(canceled)
+	}))
+	defer server.Close()
+	promAPI, err := newAPI(server.URL, Auth{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p := Prometheus{api: promAPI, QueryTimeout: 100 * time.Millisecond}
+	_, err = p.QueryRange("up", time.Now().Add(-time.Minute), time.Now(), time.Second)
+	if err == nil || err.Error() != "query timed out after 100ms" {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not canceled after the timeout")
+	}
+}
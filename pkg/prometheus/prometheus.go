@@ -16,6 +16,7 @@ package prometheus
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"math"
 	"text/template"
@@ -29,6 +30,7 @@ import (
 	"github.com/prometheus/common/model"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // NewPrometheusClient creates a prometheus struct instance with the given parameters
@@ -43,7 +45,15 @@ func NewPrometheusClient(configSpec config.Spec, url string, auth Auth, step tim
 		metadata:   metadata,
 	}
 	log.Infof("👽 Initializing prometheus client with URL: %s", url)
-	p.Client, err = prometheus.NewClient(url, auth.Token, auth.Username, auth.Password, auth.SkipTLSVerify)
+	if p.api, err = newAPI(url, auth); err != nil {
+		return &p, err
+	}
+	// The go-commons client verifies the connection, but doesn't send custom headers
+	if len(auth.Headers) > 0 {
+		err = verifyConnection(context.Background(), p.api)
+	} else {
+		p.Client, err = prometheus.NewClient(url, auth.Token, auth.Username, auth.Password, auth.SkipTLSVerify)
+	}
 	return &p, err
 }
 
@@ -86,8 +96,13 @@ func (p *Prometheus) ScrapeJobsMetrics(jobList ...Job) error {
 					}
 					docsToIndex[metric.MetricName] = append(docsToIndex[metric.MetricName], p.runInstantQuery(query, metric.MetricName, jobEnd, eachJob)...)
 				} else {
-					requiresInstant = ((jobEnd.Sub(jobStart).Milliseconds())%(p.Step.Milliseconds()) != 0)
-					docsToIndex[metric.MetricName] = append(docsToIndex[metric.MetricName], p.runRangeQuery(query, metric.MetricName, jobStart, jobEnd, eachJob)...)
+					step := p.Step
+					if metric.Step > 0 {
+						step = metric.Step
+					}
+					rangeStart := jobStart.Add(-metric.Lookback)
+					requiresInstant = ((jobEnd.Sub(rangeStart).Milliseconds())%(step.Milliseconds()) != 0)
+					docsToIndex[metric.MetricName] = append(docsToIndex[metric.MetricName], p.runRangeQuery(query, metric.MetricName, rangeStart, jobEnd, step, eachJob)...)
 				}
 				if requiresInstant {
 					docsToIndex[metric.MetricName] = append(docsToIndex[metric.MetricName], p.runInstantQuery(query, metric.MetricName, jobEnd, eachJob)...)
@@ -149,6 +164,9 @@ func (p *Prometheus) ReadProfile(location string, embedCfg *fileutils.EmbedConfi
 		if md.MetricName == "" {
 			return fmt.Errorf("metricName not defined in query number %d", i+1)
 		}
+		if md.Step < 0 || md.Lookback < 0 {
+			return fmt.Errorf("step and lookback must be >= 0 in query number %d", i+1)
+		}
 	}
 	p.MetricProfiles = append(p.MetricProfiles, metricProfile)
 	return nil
@@ -163,6 +181,7 @@ func (p *Prometheus) createMetric(query, metricName string, job Job, labels mode
 		MetricName: metricName,
 		Timestamp:  timestamp,
 		JobName:    job.JobConfig.Name,
+		Source:     p.Source,
 		Metadata:   p.metadata,
 	}
 	for k, v := range labels {
@@ -189,7 +208,7 @@ func (p *Prometheus) runInstantQuery(query, metricName string, timestamp time.Ti
 	var err error
 	var datapoints []any
 	log.Debugf("Instant query: %s", query)
-	if v, err = p.Client.Query(query, timestamp); err != nil {
+	if v, err = p.query(func() (model.Value, error) { return p.Query(query, timestamp) }); err != nil {
 		log.Warnf("Error found with query %s: %s", query, err)
 		return []any{}
 	}
@@ -200,12 +219,12 @@ func (p *Prometheus) runInstantQuery(query, metricName string, timestamp time.Ti
 }
 
 // runRangeQuery function to run a range query
-func (p *Prometheus) runRangeQuery(query, metricName string, jobStart, jobEnd time.Time, job Job) []any {
+func (p *Prometheus) runRangeQuery(query, metricName string, jobStart, jobEnd time.Time, step time.Duration, job Job) []any {
 	var v model.Value
 	var err error
 	var datapoints []any
 	log.Debugf("Range query: %s", query)
-	v, err = p.Client.QueryRange(query, jobStart, jobEnd, p.Step)
+	v, err = p.query(func() (model.Value, error) { return p.QueryRange(query, jobStart, jobEnd, step) })
 	if err != nil {
 		log.Warnf("Error found with query %s: %s", query, err)
 		return []any{}
@@ -216,6 +235,30 @@ func (p *Prometheus) runRangeQuery(query, metricName string, jobStart, jobEnd ti
 	return datapoints
 }
 
+// query runs the given query, retrying it with exponential backoff up to QueryRetries times
+func (p *Prometheus) query(queryFunc func() (model.Value, error)) (model.Value, error) {
+	var v model.Value
+	var err error
+	backoff := wait.Backoff{
+		Duration: time.Second,
+		Factor:   2,
+		Steps:    p.QueryRetries + 1,
+	}
+	attempt := 0
+	wait.ExponentialBackoff(backoff, func() (bool, error) {
+		attempt++
+		v, err = queryFunc()
+		if err != nil {
+			if attempt <= p.QueryRetries {
+				log.Debugf("Query attempt %d failed, retrying: %v", attempt, err)
+			}
+			return false, nil
+		}
+		return true, nil
+	})
+	return v, err
+}
+
 // Indexes datapoints to a specified indexer.
 func (p *Prometheus) indexDatapoints(docsToIndex map[string][]any) {
 	for metricName, docs := range docsToIndex {
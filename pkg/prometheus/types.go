@@ -20,6 +20,7 @@ import (
 	"github.com/cloud-bulldozer/go-commons/v2/indexers"
 	"github.com/cloud-bulldozer/go-commons/v2/prometheus"
 	"github.com/kube-burner/kube-burner/pkg/config"
+	apiv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 )
 
 type Auth struct {
@@ -27,19 +28,30 @@ type Auth struct {
 	Password      string
 	Token         string
 	SkipTLSVerify bool
+	// Headers sent along with every query request
+	Headers map[string]string
 }
 
 // Prometheus describes the prometheus connection
 type Prometheus struct {
-	Client         *prometheus.Prometheus
-	Endpoint       string
+	// Client go-commons prometheus client, not set when custom headers are configured as it doesn't support them
+	Client *prometheus.Prometheus
+	// api runs the metric queries, sending the auth and the custom headers along with every request
+	api      apiv1.API
+	Endpoint string
+	// Source name of the endpoint, set in the documents of the scraped metrics
+	Source         string
 	profileName    string
 	MetricProfiles []metricProfile
 	Step           time.Duration
-	UUID           string
-	ConfigSpec     config.Spec
-	metadata       map[string]any
-	indexer        *indexers.Indexer
+	// QueryRetries number of times a failed query is retried
+	QueryRetries int
+	// QueryTimeout timeout of each query attempt, 0 disables it
+	QueryTimeout time.Duration
+	UUID         string
+	ConfigSpec   config.Spec
+	metadata     map[string]any
+	indexer      *indexers.Indexer
 }
 
 type Job struct {
@@ -61,6 +73,10 @@ type metricDefinition struct {
 	MetricName   string `yaml:"metricName"`
 	Instant      bool   `yaml:"instant"`
 	CaptureStart bool   `yaml:"captureStart"`
+	// Step resolution of the range query, overrides the step of the endpoint
+	Step time.Duration `yaml:"step"`
+	// Lookback extends the range query to the given window before the job start
+	Lookback time.Duration `yaml:"lookback"`
 }
 
 type metric struct {
@@ -72,5 +88,6 @@ type metric struct {
 	ChurnMetric bool              `json:"churnMetric,omitempty"`
 	MetricName  string            `json:"metricName,omitempty"`
 	JobName     string            `json:"jobName,omitempty"`
+	Source      string            `json:"source,omitempty"`
 	Metadata    any               `json:"metadata,omitempty"`
 }
@@ -16,8 +16,12 @@ package util
 
 import (
 	"bytes"
+	"context"
+	"io"
+	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/kube-burner/kube-burner/pkg/util/fileutils"
 )
@@ -38,11 +42,28 @@ func RunShellCmd(shellCmdLine string, embedCfg *fileutils.EmbedConfiguration) (*
 		c = append([]string{"-s", "-"}, parts[1:]...)
 	}
 
-	// Create a command
-	cmd := exec.Command("/bin/sh", c...)
-
 	// Run the shell script from STDIN
-	cmd.Stdin = shellScriptReader
+	return runShell(context.Background(), shellScriptReader, nil, c...)
+}
+
+// RunShellCommand runs the given command line through /bin/sh -c with the given variables added to the environment,
+// the shell is killed when ctx is done
+func RunShellCommand(ctx context.Context, command string, env []string) (*bytes.Buffer, *bytes.Buffer, error) {
+	return runShell(ctx, nil, env, "-c", command)
+}
+
+// runShell runs /bin/sh with the given arguments and stdin, returning its outputs
+func runShell(ctx context.Context, stdin io.Reader, env []string, args ...string) (*bytes.Buffer, *bytes.Buffer, error) {
+	cmd := exec.CommandContext(ctx, "/bin/sh", args...)
+	cmd.Stdin = stdin
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	if ctx.Done() != nil {
+		killProcessGroup(cmd)
+		// Processes started by the shell may keep its outputs open after it's killed
+		cmd.WaitDelay = 10 * time.Second
+	}
 
 	// Store STDOUR and STDERR to local variables
 	var outb, errb bytes.Buffer
@@ -50,6 +71,6 @@ func RunShellCmd(shellCmdLine string, embedCfg *fileutils.EmbedConfiguration) (*
 	cmd.Stderr = &errb
 
 	// Run the command and return its return and outputs
-	err = cmd.Run()
+	err := cmd.Run()
 	return &outb, &errb, err
 }
@@ -0,0 +1,116 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+)
+
+// NewIndexer creates the indexer described by the given configuration,
+// indexers not implemented by kube-burner are created by go-commons
+func NewIndexer(indexerConfig config.IndexerConfig) (*indexers.Indexer, error) {
+	var indexer indexers.Indexer
+	var err error
+	switch indexerConfig.Type {
+	case config.OTLPIndexer:
+		indexer, err = NewOTLPIndexer(indexerConfig)
+	case config.KafkaIndexer:
+		indexer, err = NewKafkaIndexer(indexerConfig)
+	case config.RemoteWriteIndexer:
+		indexer, err = NewRemoteWriteIndexer(indexerConfig)
+	case indexers.ElasticIndexer, indexers.OpenSearchIndexer:
+		if indexerConfig.OpenSearch.Enabled() {
+			if indexerConfig.Type != indexers.OpenSearchIndexer {
+				return nil, fmt.Errorf("opensearch options are only supported by the opensearch indexer, use the http(s)://[username]:[password]@[address]:[port] notation in esServers instead")
+			}
+			if indexerConfig.Servers, err = serversWithCredentials(indexerConfig.Servers, indexerConfig.OpenSearch); err != nil {
+				return nil, err
+			}
+		}
+		if !strings.Contains(indexerConfig.Index, "{{") {
+			return indexers.NewIndexer(indexerConfig.IndexerConfig)
+		}
+		indexer, err = newTemplatedIndexer(indexerConfig)
+	case indexers.LocalIndexer:
+		if indexerConfig.PerMeasurement {
+			indexer, err = newMeasurementLocalIndexer(indexerConfig)
+			break
+		}
+		if !indexerConfig.Gzip {
+			return indexers.NewIndexer(indexerConfig.IndexerConfig)
+		}
+		indexer, err = newGzipLocalIndexer(indexerConfig.IndexerConfig)
+	default:
+		return indexers.NewIndexer(indexerConfig.IndexerConfig)
+	}
+	return &indexer, err
+}
+
+// serversWithCredentials returns the servers with the given credentials set in their URLs,
+// the http(s)://[username]:[password]@[address]:[port] notation supported by the go-commons indexer
+func serversWithCredentials(servers []string, osConfig config.OpenSearchConfig) ([]string, error) {
+	var serverURLs []string
+	for _, server := range servers {
+		serverURL, err := url.Parse(server)
+		if err != nil {
+			return nil, fmt.Errorf("invalid server URL %s: %v", server, err)
+		}
+		serverURL.User = url.UserPassword(osConfig.Username, osConfig.Password)
+		serverURLs = append(serverURLs, serverURL.String())
+	}
+	return serverURLs, nil
+}
+
+// multiIndexer fans out documents to several indexers
+type multiIndexer struct {
+	indexers []indexers.Indexer
+	types    []indexers.IndexerType
+}
+
+// NewMultiIndexer creates an indexer sending documents to all the given indexers
+func NewMultiIndexer(indexerConfigs []config.IndexerConfig) (*indexers.Indexer, error) {
+	var mi multiIndexer
+	for _, indexerConfig := range indexerConfigs {
+		indexer, err := NewIndexer(indexerConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s indexer: %v", indexerConfig.Type, err)
+		}
+		mi.indexers = append(mi.indexers, *indexer)
+		mi.types = append(mi.types, indexerConfig.Type)
+	}
+	var indexer indexers.Indexer = &mi
+	return &indexer, nil
+}
+
+// Index sends the documents to every indexer, an indexer failure doesn't prevent the documents from being sent to the remaining ones
+func (mi *multiIndexer) Index(documents []any, opts indexers.IndexingOpts) (string, error) {
+	var msgs []string
+	var errs []error
+	for i, indexer := range mi.indexers {
+		msg, err := indexer.Index(documents, opts)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s indexer: %v", mi.types[i], err))
+			continue
+		}
+		msgs = append(msgs, fmt.Sprintf("%s: %s", mi.types[i], msg))
+	}
+	return strings.Join(msgs, ", "), errors.Join(errs...)
+}
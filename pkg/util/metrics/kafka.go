@@ -0,0 +1,146 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+)
+
+const (
+	kafkaClientID        = "kube-burner"
+	kafkaTimeout         = 30 * time.Second
+	kafkaSASLPlain       = "PLAIN"
+	kafkaCompressionNone = "none"
+	kafkaCompressionGzip = "gzip"
+	kafkaRetries         = 3
+	kafkaRetryBackoff    = time.Second
+)
+
+// newKafkaProducer creates the producer used by Index, replaced in the tests
+var newKafkaProducer = sarama.NewSyncProducer
+
+// Kafka indexer instance, documents are published as JSON messages keyed by the benchmark UUID
+type Kafka struct {
+	config       config.KafkaConfig
+	saramaConfig *sarama.Config
+}
+
+// NewKafkaIndexer returns a new Kafka indexer
+func NewKafkaIndexer(indexerConfig config.IndexerConfig) (*Kafka, error) {
+	kafkaConfig := indexerConfig.Kafka
+	if len(kafkaConfig.Brokers) == 0 {
+		return nil, fmt.Errorf("kafka indexer requires at least one broker")
+	}
+	if kafkaConfig.Topic == "" {
+		return nil, fmt.Errorf("kafka indexer requires a topic")
+	}
+	if kafkaConfig.SASL.Mechanism != "" && kafkaConfig.SASL.Mechanism != kafkaSASLPlain {
+		return nil, fmt.Errorf("unsupported SASL mechanism %s, supported mechanisms: %s", kafkaConfig.SASL.Mechanism, kafkaSASLPlain)
+	}
+	if kafkaConfig.BatchSize <= 0 {
+		kafkaConfig.BatchSize = 100
+	}
+	switch kafkaConfig.Compression {
+	case "":
+		kafkaConfig.Compression = kafkaCompressionNone
+	case kafkaCompressionNone, kafkaCompressionGzip:
+	default:
+		return nil, fmt.Errorf("unsupported kafka compression %s, supported codecs: %s, %s", kafkaConfig.Compression, kafkaCompressionNone, kafkaCompressionGzip)
+	}
+	saramaConfig := newSaramaConfig(kafkaConfig, indexerConfig.InsecureSkipVerify)
+	if err := saramaConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid kafka configuration: %v", err)
+	}
+	return &Kafka{
+		config:       kafkaConfig,
+		saramaConfig: saramaConfig,
+	}, nil
+}
+
+// newSaramaConfig maps the indexer configuration to the producer configuration,
+// every produce request waits for the acknowledgement of all in-sync replicas
+func newSaramaConfig(kafkaConfig config.KafkaConfig, insecureSkipVerify bool) *sarama.Config {
+	cfg := sarama.NewConfig()
+	cfg.ClientID = kafkaClientID
+	cfg.Net.DialTimeout = kafkaTimeout
+	cfg.Net.ReadTimeout = kafkaTimeout
+	cfg.Net.WriteTimeout = kafkaTimeout
+	cfg.Producer.RequiredAcks = sarama.WaitForAll
+	cfg.Producer.Return.Successes = true
+	cfg.Producer.Partitioner = sarama.NewHashPartitioner
+	cfg.Producer.Flush.MaxMessages = kafkaConfig.BatchSize
+	cfg.Producer.Retry.Max = kafkaRetries
+	cfg.Producer.Retry.Backoff = kafkaRetryBackoff
+	if kafkaConfig.Compression == kafkaCompressionGzip {
+		cfg.Producer.Compression = sarama.CompressionGZIP
+	}
+	if kafkaConfig.TLS {
+		cfg.Net.TLS.Enable = true
+		cfg.Net.TLS.Config = &tls.Config{InsecureSkipVerify: insecureSkipVerify}
+	}
+	if kafkaConfig.SASL.Mechanism == kafkaSASLPlain {
+		cfg.Net.SASL.Enable = true
+		cfg.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		cfg.Net.SASL.User = kafkaConfig.SASL.Username
+		cfg.Net.SASL.Password = kafkaConfig.SASL.Password
+	}
+	return cfg
+}
+
+// Index publishes the given documents to the configured topic in batches of batchSize messages,
+// documents with the same UUID land in the same partition
+func (k *Kafka) Index(documents []any, opts indexers.IndexingOpts) (string, error) {
+	start := time.Now()
+	if len(documents) == 0 {
+		return "", nil
+	}
+	messages := make([]*sarama.ProducerMessage, 0, len(documents))
+	for _, document := range documents {
+		value, err := json.Marshal(document)
+		if err != nil {
+			return "", fmt.Errorf("error marshaling document: %v", err)
+		}
+		message := &sarama.ProducerMessage{Topic: k.config.Topic, Value: sarama.ByteEncoder(value)}
+		var docMap map[string]any
+		json.Unmarshal(value, &docMap)
+		if uuid, ok := docMap["uuid"].(string); ok {
+			message.Key = sarama.StringEncoder(uuid)
+		}
+		messages = append(messages, message)
+	}
+	producer, err := newKafkaProducer(k.config.Brokers, k.saramaConfig)
+	if err != nil {
+		return "", fmt.Errorf("error creating kafka producer: %v", err)
+	}
+	defer producer.Close()
+	for i := 0; i < len(messages); i += k.config.BatchSize {
+		batch := messages[i:min(i+k.config.BatchSize, len(messages))]
+		if err := producer.SendMessages(batch); err != nil {
+			// Report the cause of the first failed message rather than the number of failures
+			if producerErrs, ok := err.(sarama.ProducerErrors); ok && len(producerErrs) > 0 {
+				err = producerErrs[0].Err
+			}
+			return "", fmt.Errorf("error publishing documents to topic %s: %v", k.config.Topic, err)
+		}
+	}
+	return fmt.Sprintf("Successfully published [%d] documents to topic %s in %v", len(documents), k.config.Topic, time.Since(start).Round(time.Millisecond)), nil
+}
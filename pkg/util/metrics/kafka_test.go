@@ -0,0 +1,200 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+)
+
+func TestNewKafkaIndexer(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      config.KafkaConfig
+		wantErr     bool
+		batchSize   int
+		compression string
+	}{
+		{name: "defaults", config: config.KafkaConfig{Brokers: []string{"kafka:9092"}, Topic: "kube-burner"}, batchSize: 100, compression: kafkaCompressionNone},
+		{name: "gzip", config: config.KafkaConfig{Brokers: []string{"kafka:9092"}, Topic: "kube-burner", BatchSize: 10, Compression: "gzip"}, batchSize: 10, compression: kafkaCompressionGzip},
+		{name: "no brokers", config: config.KafkaConfig{Topic: "kube-burner"}, wantErr: true},
+		{name: "no topic", config: config.KafkaConfig{Brokers: []string{"kafka:9092"}}, wantErr: true},
+		{name: "unsupported SASL", config: config.KafkaConfig{Brokers: []string{"kafka:9092"}, Topic: "kube-burner", SASL: config.KafkaSASL{Mechanism: "SCRAM-SHA-512"}}, wantErr: true},
+		{name: "unsupported compression", config: config.KafkaConfig{Brokers: []string{"kafka:9092"}, Topic: "kube-burner", Compression: "zstd"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := NewKafkaIndexer(config.IndexerConfig{Kafka: tt.config})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if k.config.BatchSize != tt.batchSize || k.config.Compression != tt.compression {
+				t.Errorf("batchSize %d and compression %s, expected %d and %s", k.config.BatchSize, k.config.Compression, tt.batchSize, tt.compression)
+			}
+		})
+	}
+}
+
+func TestNewSaramaConfig(t *testing.T) {
+	kafkaConfig := config.KafkaConfig{
+		Brokers:     []string{"kafka:9093"},
+		Topic:       "kube-burner",
+		BatchSize:   50,
+		Compression: kafkaCompressionGzip,
+		TLS:         true,
+		SASL:        config.KafkaSASL{Mechanism: kafkaSASLPlain, Username: "user", Password: "secret"},
+	}
+	cfg := newSaramaConfig(kafkaConfig, true)
+	if cfg.Producer.RequiredAcks != sarama.WaitForAll {
+		t.Errorf("required acks %d, expected %d", cfg.Producer.RequiredAcks, sarama.WaitForAll)
+	}
+	if cfg.Producer.Compression != sarama.CompressionGZIP {
+		t.Errorf("compression %v, expected gzip", cfg.Producer.Compression)
+	}
+	if cfg.Producer.Flush.MaxMessages != 50 {
+		t.Errorf("flush max messages %d, expected 50", cfg.Producer.Flush.MaxMessages)
+	}
+	if !cfg.Net.TLS.Enable || !cfg.Net.TLS.Config.InsecureSkipVerify {
+		t.Error("expected TLS enabled without certificate verification")
+	}
+	if !cfg.Net.SASL.Enable || cfg.Net.SASL.Mechanism != sarama.SASLTypePlaintext || cfg.Net.SASL.User != "user" || cfg.Net.SASL.Password != "secret" {
+		t.Errorf("unexpected SASL configuration %+v", cfg.Net.SASL)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+// batchRecorder records the size of the batches sent through the mock producer
+type batchRecorder struct {
+	*mocks.SyncProducer
+	batches []int
+}
+
+func (b *batchRecorder) SendMessages(msgs []*sarama.ProducerMessage) error {
+	b.batches = append(b.batches, len(msgs))
+	return b.SyncProducer.SendMessages(msgs)
+}
+
+func TestKafkaIndexBatches(t *testing.T) {
+	k, err := NewKafkaIndexer(config.IndexerConfig{Kafka: config.KafkaConfig{Brokers: []string{"kafka:9092"}, Topic: "kube-burner", BatchSize: 100}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	producer := &batchRecorder{SyncProducer: mocks.NewSyncProducer(t, k.saramaConfig)}
+	documents := make([]any, 250)
+	for i := range documents {
+		documents[i] = map[string]any{"uuid": "0a1b8c6e", "value": i}
+		value := i
+		producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+			if msg.Topic != "kube-burner" {
+				return fmt.Errorf("topic %s, expected kube-burner", msg.Topic)
+			}
+			if key, _ := msg.Key.Encode(); string(key) != "0a1b8c6e" {
+				return fmt.Errorf("key %s, expected 0a1b8c6e", key)
+			}
+			raw, _ := msg.Value.Encode()
+			var doc map[string]any
+			if err := json.Unmarshal(raw, &doc); err != nil {
+				return err
+			}
+			if doc["value"] != float64(value) {
+				return fmt.Errorf("value %v, expected %d", doc["value"], value)
+			}
+			return nil
+		})
+	}
+	newKafkaProducer = func([]string, *sarama.Config) (sarama.SyncProducer, error) {
+		return producer, nil
+	}
+	defer func() { newKafkaProducer = sarama.NewSyncProducer }()
+	if _, err := k.Index(documents, indexers.IndexingOpts{}); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(producer.batches) != "[100 100 50]" {
+		t.Errorf("batches %v, expected [100 100 50]", producer.batches)
+	}
+}
+
+func TestKafkaIndexRetries(t *testing.T) {
+	const topic = "kube-burner"
+	tests := []struct {
+		name             string
+		produceResponses []any
+		wantErr          bool
+		produceRequests  int
+	}{
+		{
+			name:             "leader change",
+			produceResponses: []any{sarama.NewMockProduceResponse(t).SetError(topic, 0, sarama.ErrNotLeaderForPartition), sarama.NewMockProduceResponse(t)},
+			produceRequests:  2,
+		},
+		{
+			name:             "not enough replicas",
+			produceResponses: []any{sarama.NewMockProduceResponse(t).SetError(topic, 0, sarama.ErrNotEnoughReplicas)},
+			wantErr:          true,
+			produceRequests:  kafkaRetries + 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broker := sarama.NewMockBroker(t, 1)
+			defer broker.Close()
+			broker.SetHandlerByMap(map[string]sarama.MockResponse{
+				"MetadataRequest": sarama.NewMockMetadataResponse(t).SetBroker(broker.Addr(), broker.BrokerID()).SetLeader(topic, 0, broker.BrokerID()),
+				"ProduceRequest":  sarama.NewMockSequence(tt.produceResponses...),
+			})
+			k, err := NewKafkaIndexer(config.IndexerConfig{Kafka: config.KafkaConfig{Brokers: []string{broker.Addr()}, Topic: topic}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			k.saramaConfig.Producer.Retry.Backoff = 10 * time.Millisecond
+			_, err = k.Index([]any{map[string]any{"uuid": "0a1b8c6e"}}, indexers.IndexingOpts{})
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), sarama.ErrNotEnoughReplicas.Error()) {
+					t.Fatalf("expected %v error, got %v", sarama.ErrNotEnoughReplicas, err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			var produceRequests int
+			for _, rr := range broker.History() {
+				if req, ok := rr.Request.(*sarama.ProduceRequest); ok {
+					produceRequests++
+					if req.RequiredAcks != sarama.WaitForAll {
+						t.Errorf("required acks %d, expected %d", req.RequiredAcks, sarama.WaitForAll)
+					}
+				}
+			}
+			if produceRequests != tt.produceRequests {
+				t.Errorf("%d produce requests, expected %d", produceRequests, tt.produceRequests)
+			}
+		})
+	}
+}
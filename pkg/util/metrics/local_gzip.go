@@ -0,0 +1,77 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+)
+
+// gzipLocal local indexer writing gzip compressed files
+type gzipLocal struct {
+	metricsDirectory string
+}
+
+// newGzipLocalIndexer returns a local indexer writing the documents as gzip compressed JSON files
+func newGzipLocalIndexer(indexerConfig indexers.IndexerConfig) (*gzipLocal, error) {
+	if indexerConfig.MetricsDirectory == "" {
+		return nil, fmt.Errorf("directory name not specified")
+	}
+	err := os.MkdirAll(indexerConfig.MetricsDirectory, 0744)
+	return &gzipLocal{metricsDirectory: indexerConfig.MetricsDirectory}, err
+}
+
+// Index writes the documents into <metricName>.json.gz, documents are encoded and compressed one by one
+// so the JSON representation of the whole list is never held in memory
+func (l *gzipLocal) Index(documents []any, opts indexers.IndexingOpts) (string, error) {
+	if len(documents) == 0 {
+		return "", fmt.Errorf("empty document list in %v", opts.MetricName)
+	}
+	if opts.MetricName == "" {
+		return "", fmt.Errorf("MetricName shouldn't be empty")
+	}
+	filename := path.Join(l.metricsDirectory, fmt.Sprintf("%s.json.gz", opts.MetricName))
+	f, err := os.Create(filename)
+	if err != nil {
+		return "", fmt.Errorf("error creating metrics file %s: %s", filename, err)
+	}
+	defer f.Close()
+	gzipWriter := gzip.NewWriter(f)
+	bufWriter := bufio.NewWriter(gzipWriter)
+	jsonEnc := json.NewEncoder(bufWriter)
+	bufWriter.WriteByte('[')
+	for i, document := range documents {
+		if i > 0 {
+			bufWriter.WriteByte(',')
+		}
+		if err := jsonEnc.Encode(document); err != nil {
+			return "", fmt.Errorf("JSON encoding error: %s", err)
+		}
+	}
+	bufWriter.WriteString("]\n")
+	if err := bufWriter.Flush(); err != nil {
+		return "", fmt.Errorf("error writing metrics file %s: %s", filename, err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return "", fmt.Errorf("error writing metrics file %s: %s", filename, err)
+	}
+	return fmt.Sprintf("File %s created with %d documents", filename, len(documents)), nil
+}
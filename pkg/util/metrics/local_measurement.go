@@ -0,0 +1,175 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bufio"
+	"cmp"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+)
+
+// measurementLocal local indexer writing a file per measurement type, i.e. podLatencyMeasurement.json,
+// holding the documents of all the jobs
+type measurementLocal struct {
+	metricsDirectory string
+	gzip             bool
+	mu               sync.Mutex
+	// closingSize size of the closing bracket of the files written by this indexer, the documents of later calls are appended to them
+	closingSize map[string]int64
+}
+
+// newMeasurementLocalIndexer returns a local indexer grouping the documents by their metricName
+func newMeasurementLocalIndexer(indexerConfig config.IndexerConfig) (*measurementLocal, error) {
+	if indexerConfig.MetricsDirectory == "" {
+		return nil, fmt.Errorf("directory name not specified")
+	}
+	err := os.MkdirAll(indexerConfig.MetricsDirectory, 0744)
+	return &measurementLocal{
+		metricsDirectory: indexerConfig.MetricsDirectory,
+		gzip:             indexerConfig.Gzip,
+		closingSize:      map[string]int64{},
+	}, err
+}
+
+// Index appends the documents to the file of their metricName, documents without it are written to the file of the indexing metric name
+func (l *measurementLocal) Index(documents []any, opts indexers.IndexingOpts) (string, error) {
+	if len(documents) == 0 {
+		return "", fmt.Errorf("empty document list in %v", opts.MetricName)
+	}
+	if opts.MetricName == "" {
+		return "", fmt.Errorf("MetricName shouldn't be empty")
+	}
+	groups := map[string][]json.RawMessage{}
+	for _, document := range documents {
+		doc, err := json.Marshal(document)
+		if err != nil {
+			return "", fmt.Errorf("JSON encoding error: %s", err)
+		}
+		var m struct {
+			MetricName string `json:"metricName"`
+		}
+		json.Unmarshal(doc, &m)
+		name := cmp.Or(m.MetricName, opts.MetricName)
+		groups[name] = append(groups[name], doc)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var filenames []string
+	for _, name := range slices.Sorted(maps.Keys(groups)) {
+		filename := path.Join(l.metricsDirectory, name+".json")
+		if l.gzip {
+			filename += ".gz"
+		}
+		if err := l.appendDocuments(filename, groups[name]); err != nil {
+			return "", err
+		}
+		filenames = append(filenames, filename)
+	}
+	return fmt.Sprintf("%d documents written to %s", len(documents), strings.Join(filenames, ", ")), nil
+}
+
+// appendDocuments appends the given documents to the file, the files from previous runs are overwritten. Only the closing bracket
+// of the file is rewritten so files are valid at any time, when gzip is enabled it's written in its own gzip member, as gzip
+// readers decompress concatenated members as a single stream
+func (l *measurementLocal) appendDocuments(filename string, documents []json.RawMessage) error {
+	f, err := l.openFile(filename)
+	if err != nil {
+		return fmt.Errorf("error opening metrics file %s: %s", filename, err)
+	}
+	defer f.Close()
+	bufWriter := bufio.NewWriter(f)
+	if err := l.writeMember(bufWriter, func(w io.Writer) {
+		for i, document := range documents {
+			if i > 0 || l.closingSize[filename] > 0 {
+				w.Write([]byte{','})
+			} else {
+				w.Write([]byte{'['})
+			}
+			w.Write(document)
+			w.Write([]byte{'\n'})
+		}
+	}); err != nil {
+		return fmt.Errorf("error writing metrics file %s: %s", filename, err)
+	}
+	if err := bufWriter.Flush(); err != nil {
+		return fmt.Errorf("error writing metrics file %s: %s", filename, err)
+	}
+	bodyEnd, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("error writing metrics file %s: %s", filename, err)
+	}
+	if err := l.writeMember(bufWriter, func(w io.Writer) { w.Write([]byte("]\n")) }); err != nil {
+		return fmt.Errorf("error writing metrics file %s: %s", filename, err)
+	}
+	if err := bufWriter.Flush(); err != nil {
+		return fmt.Errorf("error writing metrics file %s: %s", filename, err)
+	}
+	end, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("error writing metrics file %s: %s", filename, err)
+	}
+	l.closingSize[filename] = end - bodyEnd
+	return nil
+}
+
+// openFile creates the file the first time it's written, later on it's opened with its closing bracket truncated
+func (l *measurementLocal) openFile(filename string) (*os.File, error) {
+	closingSize, written := l.closingSize[filename]
+	if !written {
+		return os.Create(filename)
+	}
+	f, err := os.OpenFile(filename, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	offset := info.Size() - closingSize
+	if err := f.Truncate(offset); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// writeMember writes the content to w, in a gzip member of its own when gzip is enabled
+func (l *measurementLocal) writeMember(w io.Writer, write func(w io.Writer)) error {
+	if !l.gzip {
+		write(w)
+		return nil
+	}
+	gzipWriter := gzip.NewWriter(w)
+	write(gzipWriter)
+	return gzipWriter.Close()
+}
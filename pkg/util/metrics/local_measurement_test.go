@@ -0,0 +1,94 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+)
+
+type testDocument struct {
+	MetricName string `json:"metricName"`
+	JobName    string `json:"jobName"`
+	Value      int    `json:"value"`
+}
+
+func readTestDocuments(t *testing.T, filename string, gzipped bool) []testDocument {
+	t.Helper()
+	f, err := os.Open(filename)
+	if err != nil {
+		t.Fatalf("error opening %s: %v", filename, err)
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if gzipped {
+		gzipReader, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatalf("error reading %s: %v", filename, err)
+		}
+		defer gzipReader.Close()
+		r = gzipReader
+	}
+	var documents []testDocument
+	if err := json.NewDecoder(r).Decode(&documents); err != nil {
+		t.Fatalf("error decoding %s: %v", filename, err)
+	}
+	return documents
+}
+
+func TestMeasurementLocalAppendsJobs(t *testing.T) {
+	for _, gzipped := range []bool{false, true} {
+		t.Run(map[bool]string{false: "json", true: "gzip"}[gzipped], func(t *testing.T) {
+			indexerConfig := config.IndexerConfig{Gzip: gzipped, PerMeasurement: true}
+			indexerConfig.MetricsDirectory = t.TempDir()
+			filename := path.Join(indexerConfig.MetricsDirectory, "podLatencyMeasurement.json")
+			if gzipped {
+				filename += ".gz"
+			}
+			// Leftovers from a previous run are overwritten
+			if err := os.WriteFile(filename, []byte("stale"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			l, err := newMeasurementLocalIndexer(indexerConfig)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var expected []testDocument
+			for _, job := range []string{"job-1", "job-2", "job-3"} {
+				var documents []any
+				for i := 0; i < 3; i++ {
+					document := testDocument{MetricName: "podLatencyMeasurement", JobName: job, Value: i}
+					documents = append(documents, document)
+					expected = append(expected, document)
+				}
+				if _, err := l.Index(documents, indexers.IndexingOpts{MetricName: "podLatencyMeasurement-" + job}); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				// The file is valid after each call
+				if got := readTestDocuments(t, filename, gzipped); !reflect.DeepEqual(got, expected) {
+					t.Fatalf("expected %v, got %v", expected, got)
+				}
+			}
+		})
+	}
+}
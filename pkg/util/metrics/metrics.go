@@ -16,9 +16,11 @@ package metrics
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cloud-bulldozer/go-commons/v2/indexers"
 	"github.com/kube-burner/kube-burner/pkg/alerting"
+	"github.com/kube-burner/kube-burner/pkg/config"
 	"github.com/kube-burner/kube-burner/pkg/prometheus"
 	"github.com/kube-burner/kube-burner/pkg/util"
 	log "github.com/sirupsen/logrus"
@@ -58,14 +60,28 @@ func ProcessMetricsScraperConfig(scraperConfig ScraperConfig) Scraper {
 	}
 	for pos, metricsEndpoint := range scraperConfig.ConfigSpec.MetricsEndpoints {
 		indexer = nil
+		var indexerConfigs []config.IndexerConfig
 		if metricsEndpoint.Type != "" {
+			indexerConfigs = append(indexerConfigs, metricsEndpoint.IndexerConfig)
+		}
+		indexerConfigs = append(indexerConfigs, metricsEndpoint.Indexers...)
+		if len(indexerConfigs) > 0 {
 			if metricsEndpoint.Alias == "" {
 				indexerAlias = fmt.Sprintf("indexer-%d", pos)
 			} else {
 				indexerAlias = metricsEndpoint.Alias
 			}
-			log.Infof("📁 Creating %s indexer: %s", metricsEndpoint.Type, indexerAlias)
-			indexer, err = indexers.NewIndexer(metricsEndpoint.IndexerConfig)
+			if len(indexerConfigs) == 1 {
+				log.Infof("📁 Creating %s indexer: %s", indexerConfigs[0].Type, indexerAlias)
+				indexer, err = NewIndexer(indexerConfigs[0])
+			} else {
+				var indexerTypes []string
+				for _, indexerConfig := range indexerConfigs {
+					indexerTypes = append(indexerTypes, string(indexerConfig.Type))
+				}
+				log.Infof("📁 Creating %s indexers: %s", strings.Join(indexerTypes, ", "), indexerAlias)
+				indexer, err = NewMultiIndexer(indexerConfigs)
+			}
 			if err != nil {
 				log.Fatalf("Error creating indexer %d: %v", pos, err.Error())
 			}
@@ -77,11 +93,18 @@ func ProcessMetricsScraperConfig(scraperConfig ScraperConfig) Scraper {
 				Password:      metricsEndpoint.Password,
 				Token:         metricsEndpoint.Token,
 				SkipTLSVerify: metricsEndpoint.SkipTLSVerify,
+				Headers:       metricsEndpoint.Headers,
 			}
 			p, err := prometheus.NewPrometheusClient(*scraperConfig.ConfigSpec, metricsEndpoint.Endpoint, auth, metricsEndpoint.Step, scraperConfig.MetricsMetadata, indexer)
 			if err != nil {
 				log.Fatal(err)
 			}
+			p.QueryRetries, p.QueryTimeout = metricsEndpoint.QueryRetries, metricsEndpoint.QueryTimeout
+			// The metrics scraped from each endpoint are tagged with its alias, or its URL when not set
+			p.Source = metricsEndpoint.Alias
+			if p.Source == "" {
+				p.Source = metricsEndpoint.Endpoint
+			}
 			prometheusClients = append(prometheusClients, p)
 			for _, metricProfile := range metricsEndpoint.Metrics {
 				if indexer == nil {
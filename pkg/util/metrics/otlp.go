@@ -0,0 +1,333 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/cloud-bulldozer/go-commons/v2/version"
+	"github.com/kube-burner/kube-burner/pkg/config"
+)
+
+const (
+	otlpProtocolGRPC        = "grpc"
+	otlpProtocolHTTPJSON    = "http/json"
+	otlpDefaultGRPCEndpoint = "http://localhost:4317"
+	otlpDefaultHTTPEndpoint = "http://localhost:4318"
+	otlpBatchSize           = 1000
+	otlpScope               = "kube-burner"
+	otlpTimeout             = 30 * time.Second
+)
+
+// Numeric fields of the latency quantile documents exported as OTLP gauges
+var otlpQuantileFields = []string{"P99", "P95", "P50", "min", "max", "avg"}
+
+// OTLP indexer instance, documents are exported as OTLP logs and latency quantiles as OTLP metrics using OTLP/gRPC or OTLP/HTTP with JSON encoding
+type OTLP struct {
+	endpoint     string
+	protocol     string
+	headers      map[string]string
+	resource     otlpResource
+	httpClient   *http.Client
+	grpcExporter *otlpGRPCExporter
+}
+
+type otlpAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Body         otlpAnyValue   `json:"body"`
+	Attributes   []otlpKeyValue `json:"attributes"`
+}
+
+type otlpLogsRequest struct {
+	ResourceLogs []struct {
+		Resource  otlpResource `json:"resource"`
+		ScopeLogs []struct {
+			Scope      otlpScopeInfo   `json:"scope"`
+			LogRecords []otlpLogRecord `json:"logRecords"`
+		} `json:"scopeLogs"`
+	} `json:"resourceLogs"`
+}
+
+type otlpDataPoint struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	AsDouble     float64        `json:"asDouble"`
+	Attributes   []otlpKeyValue `json:"attributes"`
+}
+
+type otlpMetric struct {
+	Name  string `json:"name"`
+	Unit  string `json:"unit"`
+	Gauge struct {
+		DataPoints []otlpDataPoint `json:"dataPoints"`
+	} `json:"gauge"`
+}
+
+type otlpMetricsRequest struct {
+	ResourceMetrics []struct {
+		Resource     otlpResource `json:"resource"`
+		ScopeMetrics []struct {
+			Scope   otlpScopeInfo `json:"scope"`
+			Metrics []otlpMetric  `json:"metrics"`
+		} `json:"scopeMetrics"`
+	} `json:"resourceMetrics"`
+}
+
+// NewOTLPIndexer returns a new OTLP indexer, the endpoint, protocol, headers and resource attributes not configured are taken from the
+// OTEL_EXPORTER_OTLP_ENDPOINT, OTEL_EXPORTER_OTLP_PROTOCOL, OTEL_EXPORTER_OTLP_HEADERS and OTEL_RESOURCE_ATTRIBUTES environment variables
+func NewOTLPIndexer(indexerConfig config.IndexerConfig) (*OTLP, error) {
+	protocol := cmp.Or(indexerConfig.OTLP.Protocol, os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"), otlpProtocolHTTPJSON)
+	defaultEndpoint := otlpDefaultHTTPEndpoint
+	if protocol == otlpProtocolGRPC {
+		defaultEndpoint = otlpDefaultGRPCEndpoint
+	}
+	endpoint := cmp.Or(indexerConfig.OTLP.Endpoint, os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"), defaultEndpoint)
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		return nil, fmt.Errorf("invalid OTLP endpoint %s, the endpoint must start with http:// or https://", endpoint)
+	}
+	var httpClient *http.Client
+	var grpcExporter *otlpGRPCExporter
+	var err error
+	switch protocol {
+	case otlpProtocolGRPC:
+		if grpcExporter, err = newOTLPGRPCExporter(endpoint, indexerConfig.InsecureSkipVerify); err != nil {
+			return nil, err
+		}
+	case otlpProtocolHTTPJSON:
+		httpClient = &http.Client{
+			Timeout: otlpTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: indexerConfig.InsecureSkipVerify},
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol %s, supported protocols: %s, %s", protocol, otlpProtocolGRPC, otlpProtocolHTTPJSON)
+	}
+	headers := parseOTLPKeyValues(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS"))
+	maps.Copy(headers, indexerConfig.OTLP.Headers)
+	resourceAttributes := map[string]string{
+		"service.name":    otlpScope,
+		"service.version": version.Version,
+	}
+	maps.Copy(resourceAttributes, parseOTLPKeyValues(os.Getenv("OTEL_RESOURCE_ATTRIBUTES")))
+	maps.Copy(resourceAttributes, indexerConfig.OTLP.ResourceAttributes)
+	var resource otlpResource
+	for k, v := range resourceAttributes {
+		resource.Attributes = append(resource.Attributes, otlpKeyValue{Key: k, Value: otlpAnyValue{StringValue: &v}})
+	}
+	return &OTLP{
+		endpoint:     strings.TrimSuffix(endpoint, "/"),
+		protocol:     protocol,
+		headers:      headers,
+		resource:     resource,
+		httpClient:   httpClient,
+		grpcExporter: grpcExporter,
+	}, nil
+}
+
+// Index exports the given documents as OTLP logs, latency quantile documents are also exported as OTLP gauges
+func (o *OTLP) Index(documents []any, opts indexers.IndexingOpts) (string, error) {
+	start := time.Now()
+	for i := 0; i < len(documents); i += otlpBatchSize {
+		var logRecords []otlpLogRecord
+		var dataPoints = make(map[string][]otlpDataPoint)
+		for _, document := range documents[i:min(i+otlpBatchSize, len(documents))] {
+			j, err := json.Marshal(document)
+			if err != nil {
+				return "", fmt.Errorf("error marshaling document: %v", err)
+			}
+			docMap := make(map[string]any)
+			json.Unmarshal(j, &docMap)
+			timestamp := otlpTimestamp(docMap)
+			attributes := toOTLPAttributes(docMap, opts.MetricName)
+			body := string(j)
+			logRecords = append(logRecords, otlpLogRecord{
+				TimeUnixNano: timestamp,
+				Body:         otlpAnyValue{StringValue: &body},
+				Attributes:   attributes,
+			})
+			if _, ok := docMap["quantileName"]; !ok {
+				continue
+			}
+			for _, field := range otlpQuantileFields {
+				if value, ok := docMap[field].(float64); ok {
+					dataPoints[field] = append(dataPoints[field], otlpDataPoint{
+						TimeUnixNano: timestamp,
+						AsDouble:     value,
+						Attributes:   attributes,
+					})
+				}
+			}
+		}
+		if err := o.exportLogs(logRecords); err != nil {
+			return "", err
+		}
+		if len(dataPoints) > 0 {
+			if err := o.exportMetrics(opts.MetricName, dataPoints); err != nil {
+				return "", err
+			}
+		}
+	}
+	return fmt.Sprintf("Successfully exported [%d] documents to %s in %v", len(documents), o.endpoint, time.Since(start).Round(time.Millisecond)), nil
+}
+
+func (o *OTLP) exportLogs(logRecords []otlpLogRecord) error {
+	var request otlpLogsRequest
+	request.ResourceLogs = make([]struct {
+		Resource  otlpResource `json:"resource"`
+		ScopeLogs []struct {
+			Scope      otlpScopeInfo   `json:"scope"`
+			LogRecords []otlpLogRecord `json:"logRecords"`
+		} `json:"scopeLogs"`
+	}, 1)
+	request.ResourceLogs[0].Resource = o.resource
+	request.ResourceLogs[0].ScopeLogs = make([]struct {
+		Scope      otlpScopeInfo   `json:"scope"`
+		LogRecords []otlpLogRecord `json:"logRecords"`
+	}, 1)
+	request.ResourceLogs[0].ScopeLogs[0].Scope = otlpScopeInfo{Name: otlpScope, Version: version.Version}
+	request.ResourceLogs[0].ScopeLogs[0].LogRecords = logRecords
+	if o.protocol == otlpProtocolGRPC {
+		return o.exportLogsGRPC(request)
+	}
+	return o.export("/v1/logs", request)
+}
+
+func (o *OTLP) exportMetrics(metricName string, dataPoints map[string][]otlpDataPoint) error {
+	var request otlpMetricsRequest
+	var otlpMetrics []otlpMetric
+	for field, points := range dataPoints {
+		metric := otlpMetric{
+			Name: fmt.Sprintf("kube_burner.%s.%s", metricName, strings.ToLower(field)),
+			Unit: "ms",
+		}
+		metric.Gauge.DataPoints = points
+		otlpMetrics = append(otlpMetrics, metric)
+	}
+	request.ResourceMetrics = make([]struct {
+		Resource     otlpResource `json:"resource"`
+		ScopeMetrics []struct {
+			Scope   otlpScopeInfo `json:"scope"`
+			Metrics []otlpMetric  `json:"metrics"`
+		} `json:"scopeMetrics"`
+	}, 1)
+	request.ResourceMetrics[0].Resource = o.resource
+	request.ResourceMetrics[0].ScopeMetrics = make([]struct {
+		Scope   otlpScopeInfo `json:"scope"`
+		Metrics []otlpMetric  `json:"metrics"`
+	}, 1)
+	request.ResourceMetrics[0].ScopeMetrics[0].Scope = otlpScopeInfo{Name: otlpScope, Version: version.Version}
+	request.ResourceMetrics[0].ScopeMetrics[0].Metrics = otlpMetrics
+	if o.protocol == otlpProtocolGRPC {
+		return o.exportMetricsGRPC(request)
+	}
+	return o.export("/v1/metrics", request)
+}
+
+func (o *OTLP) export(path string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error marshaling OTLP request: %v", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, o.endpoint+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range o.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error exporting to %s: %v", o.endpoint+path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("error exporting to %s: %s: %s", o.endpoint+path, resp.Status, body)
+	}
+	return nil
+}
+
+// toOTLPAttributes converts the scalar fields of the document into OTLP attributes
+func toOTLPAttributes(docMap map[string]any, metricName string) []otlpKeyValue {
+	attributes := []otlpKeyValue{{Key: "metricName", Value: otlpAnyValue{StringValue: &metricName}}}
+	for k, v := range docMap {
+		switch value := v.(type) {
+		case string:
+			if k != "metricName" {
+				attributes = append(attributes, otlpKeyValue{Key: k, Value: otlpAnyValue{StringValue: &value}})
+			}
+		case float64:
+			attributes = append(attributes, otlpKeyValue{Key: k, Value: otlpAnyValue{DoubleValue: &value}})
+		case bool:
+			attributes = append(attributes, otlpKeyValue{Key: k, Value: otlpAnyValue{BoolValue: &value}})
+		}
+	}
+	return attributes
+}
+
+// otlpTimestamp returns the timestamp of the document in nanoseconds, the current time is used when the document has no timestamp
+func otlpTimestamp(docMap map[string]any) string {
+	timestamp := time.Now()
+	if ts, ok := docMap["timestamp"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			timestamp = t
+		}
+	}
+	return strconv.FormatInt(timestamp.UnixNano(), 10)
+}
+
+// parseOTLPKeyValues parses lists of key=value pairs separated by commas as used by the OTEL_* environment variables
+func parseOTLPKeyValues(keyValues string) map[string]string {
+	parsed := make(map[string]string)
+	for _, kv := range strings.Split(keyValues, ",") {
+		if k, v, found := strings.Cut(kv, "="); found {
+			parsed[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+	return parsed
+}
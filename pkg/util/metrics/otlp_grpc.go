@@ -0,0 +1,177 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package metrics
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// otlpGRPCExporter exports the OTLP requests using the OTLP/gRPC logs and metrics services
+type otlpGRPCExporter struct {
+	logs    collogspb.LogsServiceClient
+	metrics colmetricspb.MetricsServiceClient
+}
+
+// newOTLPGRPCExporter returns an OTLP/gRPC exporter, http endpoints use plaintext connections and https endpoints use TLS
+func newOTLPGRPCExporter(endpoint string, insecureSkipVerify bool) (*otlpGRPCExporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTLP endpoint %s: %v", endpoint, err)
+	}
+	creds := insecure.NewCredentials()
+	if u.Scheme == "https" {
+		creds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: insecureSkipVerify})
+	}
+	conn, err := grpc.NewClient(u.Host, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("error creating OTLP/gRPC client for %s: %v", endpoint, err)
+	}
+	return &otlpGRPCExporter{
+		logs:    collogspb.NewLogsServiceClient(conn),
+		metrics: colmetricspb.NewMetricsServiceClient(conn),
+	}, nil
+}
+
+// exportContext returns the context of an export call, carrying the configured headers as gRPC metadata
+func (o *OTLP) exportContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), otlpTimeout)
+	return metadata.NewOutgoingContext(ctx, metadata.New(o.headers)), cancel
+}
+
+func (o *OTLP) exportLogsGRPC(request otlpLogsRequest) error {
+	ctx, cancel := o.exportContext()
+	defer cancel()
+	resp, err := o.grpcExporter.logs.Export(ctx, request.toProto())
+	if err != nil {
+		return fmt.Errorf("error exporting logs to %s: %v", o.endpoint, err)
+	}
+	if rejected := resp.GetPartialSuccess().GetRejectedLogRecords(); rejected > 0 {
+		return fmt.Errorf("error exporting logs to %s: %d log records rejected: %s", o.endpoint, rejected, resp.GetPartialSuccess().GetErrorMessage())
+	}
+	return nil
+}
+
+func (o *OTLP) exportMetricsGRPC(request otlpMetricsRequest) error {
+	ctx, cancel := o.exportContext()
+	defer cancel()
+	resp, err := o.grpcExporter.metrics.Export(ctx, request.toProto())
+	if err != nil {
+		return fmt.Errorf("error exporting metrics to %s: %v", o.endpoint, err)
+	}
+	if rejected := resp.GetPartialSuccess().GetRejectedDataPoints(); rejected > 0 {
+		return fmt.Errorf("error exporting metrics to %s: %d data points rejected: %s", o.endpoint, rejected, resp.GetPartialSuccess().GetErrorMessage())
+	}
+	return nil
+}
+
+// The toProto methods convert the requests into the opentelemetry-proto messages
+
+func (r otlpLogsRequest) toProto() *collogspb.ExportLogsServiceRequest {
+	request := &collogspb.ExportLogsServiceRequest{}
+	for _, rl := range r.ResourceLogs {
+		resourceLogs := &logspb.ResourceLogs{Resource: rl.Resource.toProto()}
+		for _, sl := range rl.ScopeLogs {
+			scopeLogs := &logspb.ScopeLogs{Scope: sl.Scope.toProto()}
+			for _, lr := range sl.LogRecords {
+				scopeLogs.LogRecords = append(scopeLogs.LogRecords, &logspb.LogRecord{
+					TimeUnixNano: parseUnixNano(lr.TimeUnixNano),
+					Body:         lr.Body.toProto(),
+					Attributes:   toProtoAttributes(lr.Attributes),
+				})
+			}
+			resourceLogs.ScopeLogs = append(resourceLogs.ScopeLogs, scopeLogs)
+		}
+		request.ResourceLogs = append(request.ResourceLogs, resourceLogs)
+	}
+	return request
+}
+
+func (r otlpMetricsRequest) toProto() *colmetricspb.ExportMetricsServiceRequest {
+	request := &colmetricspb.ExportMetricsServiceRequest{}
+	for _, rm := range r.ResourceMetrics {
+		resourceMetrics := &metricspb.ResourceMetrics{Resource: rm.Resource.toProto()}
+		for _, sm := range rm.ScopeMetrics {
+			scopeMetrics := &metricspb.ScopeMetrics{Scope: sm.Scope.toProto()}
+			for _, m := range sm.Metrics {
+				gauge := &metricspb.Gauge{}
+				for _, dp := range m.Gauge.DataPoints {
+					gauge.DataPoints = append(gauge.DataPoints, &metricspb.NumberDataPoint{
+						TimeUnixNano: parseUnixNano(dp.TimeUnixNano),
+						Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: dp.AsDouble},
+						Attributes:   toProtoAttributes(dp.Attributes),
+					})
+				}
+				scopeMetrics.Metrics = append(scopeMetrics.Metrics, &metricspb.Metric{
+					Name: m.Name,
+					Unit: m.Unit,
+					Data: &metricspb.Metric_Gauge{Gauge: gauge},
+				})
+			}
+			resourceMetrics.ScopeMetrics = append(resourceMetrics.ScopeMetrics, scopeMetrics)
+		}
+		request.ResourceMetrics = append(request.ResourceMetrics, resourceMetrics)
+	}
+	return request
+}
+
+func (r otlpResource) toProto() *resourcepb.Resource {
+	return &resourcepb.Resource{Attributes: toProtoAttributes(r.Attributes)}
+}
+
+func (s otlpScopeInfo) toProto() *commonpb.InstrumentationScope {
+	return &commonpb.InstrumentationScope{Name: s.Name, Version: s.Version}
+}
+
+func (v otlpAnyValue) toProto() *commonpb.AnyValue {
+	switch {
+	case v.StringValue != nil:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: *v.StringValue}}
+	case v.BoolValue != nil:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: *v.BoolValue}}
+	case v.DoubleValue != nil:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: *v.DoubleValue}}
+	}
+	return &commonpb.AnyValue{}
+}
+
+func toProtoAttributes(attributes []otlpKeyValue) []*commonpb.KeyValue {
+	var keyValues []*commonpb.KeyValue
+	for _, kv := range attributes {
+		keyValues = append(keyValues, &commonpb.KeyValue{Key: kv.Key, Value: kv.Value.toProto()})
+	}
+	return keyValues
+}
+
+// parseUnixNano parses the nanoseconds timestamp given in decimal format, as used by OTLP/JSON
+func parseUnixNano(nanos string) uint64 {
+	v, _ := strconv.ParseUint(nanos, 10, 64)
+	return v
+}
@@ -0,0 +1,214 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var otlpTestDocuments = []any{
+	map[string]any{"uuid": "uuid-1", "timestamp": "2025-03-11T09:12:04Z", "podName": "pod-1"},
+	map[string]any{"uuid": "uuid-1", "quantileName": "Ready", "P99": 1200.0, "P50": 800.0},
+}
+
+// fakeCollector records the paths and bodies of the export requests
+type fakeCollector struct {
+	mu       sync.Mutex
+	requests map[string][][]byte
+}
+
+func (c *fakeCollector) record(path string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.requests == nil {
+		c.requests = make(map[string][][]byte)
+	}
+	c.requests[path] = append(c.requests[path], body)
+}
+
+func TestOTLPHTTPExport(t *testing.T) {
+	var collector fakeCollector
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" || r.Header.Get("X-Tenant") != "perf" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		collector.record(r.URL.Path, body)
+	}))
+	defer server.Close()
+	o, err := NewOTLPIndexer(config.IndexerConfig{OTLP: config.OTLPConfig{Endpoint: server.URL, Protocol: otlpProtocolHTTPJSON, Headers: map[string]string{"X-Tenant": "perf"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.Index(otlpTestDocuments, indexers.IndexingOpts{MetricName: "podLatencyQuantilesMeasurement"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(collector.requests["/v1/logs"]) != 1 || len(collector.requests["/v1/metrics"]) != 1 {
+		t.Fatalf("unexpected export requests: %v", collector.requests)
+	}
+	var logs otlpLogsRequest
+	if err := json.Unmarshal(collector.requests["/v1/logs"][0], &logs); err != nil {
+		t.Fatal(err)
+	}
+	if records := len(logs.ResourceLogs[0].ScopeLogs[0].LogRecords); records != 2 {
+		t.Errorf("%d log records, expected 2", records)
+	}
+	var metrics otlpMetricsRequest
+	if err := json.Unmarshal(collector.requests["/v1/metrics"][0], &metrics); err != nil {
+		t.Fatal(err)
+	}
+	if gauges := len(metrics.ResourceMetrics[0].ScopeMetrics[0].Metrics); gauges != 2 {
+		t.Errorf("%d gauges, expected 2", gauges)
+	}
+}
+
+// fakeGRPCCollector records the OTLP/gRPC export requests and their metadata
+type fakeGRPCCollector struct {
+	collogspb.UnimplementedLogsServiceServer
+	colmetricspb.UnimplementedMetricsServiceServer
+	mu        sync.Mutex
+	logs      []*collogspb.ExportLogsServiceRequest
+	metrics   []*colmetricspb.ExportMetricsServiceRequest
+	tenants   []string
+	exportErr error
+}
+
+func (c *fakeGRPCCollector) recordTenant(ctx context.Context) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	c.tenants = append(c.tenants, md.Get("x-tenant")...)
+}
+
+func (c *fakeGRPCCollector) Export(ctx context.Context, request *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.recordTenant(ctx)
+	c.logs = append(c.logs, request)
+	return &collogspb.ExportLogsServiceResponse{}, c.exportErr
+}
+
+// fakeGRPCMetricsCollector adapts the metrics service of the collector, as both services define Export
+type fakeGRPCMetricsCollector struct {
+	*fakeGRPCCollector
+}
+
+func (c fakeGRPCMetricsCollector) Export(ctx context.Context, request *colmetricspb.ExportMetricsServiceRequest) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.recordTenant(ctx)
+	c.metrics = append(c.metrics, request)
+	return &colmetricspb.ExportMetricsServiceResponse{}, c.exportErr
+}
+
+func TestOTLPGRPCExport(t *testing.T) {
+	collector := &fakeGRPCCollector{}
+	server := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(server, collector)
+	colmetricspb.RegisterMetricsServiceServer(server, fakeGRPCMetricsCollector{collector})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Serve(listener)
+	defer server.Stop()
+	o, err := NewOTLPIndexer(config.IndexerConfig{OTLP: config.OTLPConfig{Endpoint: "http://" + listener.Addr().String(), Protocol: otlpProtocolGRPC, Headers: map[string]string{"X-Tenant": "perf"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.Index(otlpTestDocuments, indexers.IndexingOpts{MetricName: "podLatencyQuantilesMeasurement"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(collector.logs) != 1 || len(collector.metrics) != 1 {
+		t.Fatalf("unexpected export requests: %d logs, %d metrics", len(collector.logs), len(collector.metrics))
+	}
+	if !slices.Equal(collector.tenants, []string{"perf", "perf"}) {
+		t.Errorf("unexpected x-tenant metadata %v", collector.tenants)
+	}
+	scopeLogs := collector.logs[0].ResourceLogs[0].ScopeLogs[0]
+	if records := len(scopeLogs.LogRecords); records != 2 {
+		t.Errorf("%d log records, expected 2", records)
+	}
+	if scopeLogs.Scope.Name != otlpScope {
+		t.Errorf("unexpected instrumentation scope %q", scopeLogs.Scope.Name)
+	}
+	if ts := scopeLogs.LogRecords[0].TimeUnixNano; ts != uint64(time.Date(2025, 3, 11, 9, 12, 4, 0, time.UTC).UnixNano()) {
+		t.Errorf("unexpected log record timestamp %d", ts)
+	}
+	gauges := collector.metrics[0].ResourceMetrics[0].ScopeMetrics[0].Metrics
+	if len(gauges) != 2 {
+		t.Fatalf("%d gauges, expected 2", len(gauges))
+	}
+	for _, gauge := range gauges {
+		value := gauge.GetGauge().DataPoints[0].GetAsDouble()
+		if (gauge.Name == "kube_burner.podLatencyQuantilesMeasurement.p99" && value != 1200) || (gauge.Name == "kube_burner.podLatencyQuantilesMeasurement.p50" && value != 800) {
+			t.Errorf("unexpected value %v of gauge %s", value, gauge.Name)
+		}
+	}
+	collector.exportErr = status.Error(codes.InvalidArgument, "rejected")
+	if _, err := o.Index(otlpTestDocuments, indexers.IndexingOpts{MetricName: "podLatencyQuantilesMeasurement"}); err == nil {
+		t.Error("expected error with an InvalidArgument status")
+	}
+}
+
+func TestNewOTLPIndexer(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   config.OTLPConfig
+		wantErr  bool
+		endpoint string
+	}{
+		{name: "http defaults", config: config.OTLPConfig{}, endpoint: otlpDefaultHTTPEndpoint},
+		{name: "grpc defaults", config: config.OTLPConfig{Protocol: otlpProtocolGRPC}, endpoint: otlpDefaultGRPCEndpoint},
+		{name: "endpoint without scheme", config: config.OTLPConfig{Endpoint: "collector:4317", Protocol: otlpProtocolGRPC}, wantErr: true},
+		{name: "unsupported protocol", config: config.OTLPConfig{Protocol: "http/protobuf"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+			t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "")
+			o, err := NewOTLPIndexer(config.IndexerConfig{OTLP: tt.config})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if o.endpoint != tt.endpoint {
+				t.Errorf("endpoint %s, expected %s", o.endpoint, tt.endpoint)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/cloud-bulldozer/go-commons/v2/version"
+	"github.com/klauspost/compress/snappy"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/prometheus/prometheus/prompb"
+)
+
+const remoteWriteBatchSize = 1000
+
+// remoteWriteJobLabel label holding the kube-burner job, namespaced so it doesn't collide with the job label of the Prometheus metrics
+const remoteWriteJobLabel = "kube_burner_job"
+
+// Quantile label of the min and max fields of the latency quantile documents
+var remoteWriteQuantiles = map[string]string{
+	"min": "0",
+	"max": "1",
+}
+
+// quantileFieldRegex matches the quantile fields of the latency quantile documents, including custom quantiles, i.e. P99 or P999
+var quantileFieldRegex = regexp.MustCompile(`^P([0-9]+)$`)
+
+// RemoteWrite indexer instance, pushes documents to a Prometheus remote-write endpoint
+type RemoteWrite struct {
+	config     config.RemoteWriteConfig
+	httpClient *http.Client
+}
+
+// NewRemoteWriteIndexer returns a new Prometheus remote-write indexer
+func NewRemoteWriteIndexer(indexerConfig config.IndexerConfig) (*RemoteWrite, error) {
+	if indexerConfig.RemoteWrite.URL == "" {
+		return nil, fmt.Errorf("remote-write indexer requires an url")
+	}
+	return &RemoteWrite{
+		config: indexerConfig.RemoteWrite,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: indexerConfig.InsecureSkipVerify},
+			},
+		},
+	}, nil
+}
+
+// Index converts the documents into time series and pushes them, latency quantile documents are converted into
+// kube_burner_<metricName> series with the quantile label, and Prometheus metric documents keep their own labels.
+// Documents without numeric values are skipped
+func (rw *RemoteWrite) Index(documents []any, opts indexers.IndexingOpts) (string, error) {
+	start := time.Now()
+	var series []prompb.TimeSeries
+	for _, document := range documents {
+		j, err := json.Marshal(document)
+		if err != nil {
+			return "", fmt.Errorf("error marshaling document: %v", err)
+		}
+		docMap := make(map[string]any)
+		json.Unmarshal(j, &docMap)
+		series = append(series, toRemoteWriteSeries(docMap, opts.MetricName)...)
+	}
+	for i := 0; i < len(series); i += remoteWriteBatchSize {
+		if err := rw.push(series[i:min(i+remoteWriteBatchSize, len(series))]); err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf("Successfully pushed [%d] samples from %d documents to %s in %v", len(series), len(documents), rw.config.URL, time.Since(start).Round(time.Millisecond)), nil
+}
+
+func (rw *RemoteWrite) push(series []prompb.TimeSeries) error {
+	writeRequest := prompb.WriteRequest{Timeseries: series}
+	data, err := writeRequest.Marshal()
+	if err != nil {
+		return fmt.Errorf("error marshaling write request: %v", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, rw.config.URL, bytes.NewReader(snappy.Encode(nil, data)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("User-Agent", "kube-burner/"+version.Version)
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	if rw.config.Username != "" {
+		req.SetBasicAuth(rw.config.Username, rw.config.Password)
+	} else if rw.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+rw.config.Token)
+	}
+	for k, v := range rw.config.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := rw.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error pushing samples to %s: %v", rw.config.URL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("error pushing samples to %s: %s: %s", rw.config.URL, resp.Status, body)
+	}
+	return nil
+}
+
+// toRemoteWriteSeries converts a document into time series
+func toRemoteWriteSeries(docMap map[string]any, metricName string) []prompb.TimeSeries {
+	var series []prompb.TimeSeries
+	if name, ok := docMap["metricName"].(string); ok {
+		metricName = name
+	}
+	timestamp := time.Now()
+	if ts, ok := docMap["timestamp"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			timestamp = t
+		}
+	}
+	labels := map[string]string{
+		"__name__": "kube_burner_" + sanitizeMetricName(metricName),
+	}
+	if jobName, ok := docMap["jobName"].(string); ok {
+		labels[remoteWriteJobLabel] = jobName
+	}
+	if uuid, ok := docMap["uuid"].(string); ok {
+		labels["uuid"] = uuid
+	}
+	// Latency quantile documents
+	if quantileName, ok := docMap["quantileName"].(string); ok {
+		labels["quantileName"] = quantileName
+		for field, v := range docMap {
+			value, ok := v.(float64)
+			if !ok {
+				continue
+			}
+			quantile, ok := remoteWriteQuantiles[field]
+			if !ok {
+				if quantile, ok = quantileLabel(field); !ok {
+					continue
+				}
+			}
+			labels["quantile"] = quantile
+			series = append(series, newRemoteWriteSeries(labels, value, timestamp))
+		}
+		delete(labels, "quantile")
+		if value, ok := docMap["avg"].(float64); ok {
+			labels["__name__"] += "_avg"
+			series = append(series, newRemoteWriteSeries(labels, value, timestamp))
+		}
+		return series
+	}
+	// Prometheus metric documents, their labels take precedence over the kube-burner ones
+	if value, ok := docMap["value"].(float64); ok {
+		if metricLabels, ok := docMap["labels"].(map[string]any); ok {
+			for k, v := range metricLabels {
+				if v, ok := v.(string); ok && k != "__name__" {
+					labels[k] = v
+				}
+			}
+		}
+		series = append(series, newRemoteWriteSeries(labels, value, timestamp))
+	}
+	return series
+}
+
+// quantileLabel returns the quantile label of a quantile field, named after its percentile without the decimal point, i.e. P99 is 0.99,
+// P999 is 0.999, P5 is 0.05 and P05 is 0.005. The first two digits are taken as the integer part of the percentile, or only
+// the first one when it's a zero
+func quantileLabel(field string) (string, bool) {
+	match := quantileFieldRegex.FindStringSubmatch(field)
+	if match == nil {
+		return "", false
+	}
+	digits := match[1]
+	if len(digits) > 1 && digits[0] == '0' {
+		digits = "0." + digits[1:]
+	} else if digits != "100" && len(digits) > 2 {
+		digits = digits[:2] + "." + digits[2:]
+	}
+	percentile, err := strconv.ParseFloat(digits, 64)
+	if err != nil || percentile > 100 {
+		return "", false
+	}
+	// Rounded to drop float artifacts, i.e. 0.9990000000000001
+	return strconv.FormatFloat(math.Round(percentile*1e4)/1e6, 'f', -1, 64), true
+}
+
+func newRemoteWriteSeries(labels map[string]string, value float64, timestamp time.Time) prompb.TimeSeries {
+	s := prompb.TimeSeries{
+		Samples: []prompb.Sample{{Value: value, Timestamp: timestamp.UnixMilli()}},
+	}
+	for k, v := range labels {
+		s.Labels = append(s.Labels, prompb.Label{Name: k, Value: v})
+	}
+	// Remote-write requires labels sorted by name
+	sort.Slice(s.Labels, func(i, j int) bool {
+		return s.Labels[i].Name < s.Labels[j].Name
+	})
+	return s
+}
+
+// sanitizeMetricName replaces the characters not allowed in Prometheus metric names
+func sanitizeMetricName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == ':' {
+			return r
+		}
+		return '_'
+	}, name)
+}
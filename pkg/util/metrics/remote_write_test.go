@@ -0,0 +1,217 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/klauspost/compress/snappy"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/prometheus/prometheus/prompb"
+)
+
+func TestRemoteWritePush(t *testing.T) {
+	var received []prompb.TimeSeries
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") != "snappy" || r.Header.Get("Content-Type") != "application/x-protobuf" {
+			t.Errorf("unexpected headers %v", r.Header)
+		}
+		compressed, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := snappy.Decode(nil, compressed)
+		if err != nil {
+			t.Fatalf("error decoding snappy body: %v", err)
+		}
+		var writeRequest prompb.WriteRequest
+		if err := writeRequest.Unmarshal(data); err != nil {
+			t.Fatalf("error unmarshaling write request: %v", err)
+		}
+		received = append(received, writeRequest.Timeseries...)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	rw, err := NewRemoteWriteIndexer(config.IndexerConfig{RemoteWrite: config.RemoteWriteConfig{URL: server.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var documents []any
+	// More documents than a batch, so several requests are sent
+	for i := 0; i < remoteWriteBatchSize+500; i++ {
+		documents = append(documents, map[string]any{
+			"metricName": "cpu-kubelet",
+			"uuid":       "6e2a9e4a-ef2c-4bd1-9b2f-5eb3d4f1e1a2",
+			"timestamp":  time.Date(2024, 1, 1, 0, 0, i, 0, time.UTC).Format(time.RFC3339Nano),
+			"value":      float64(i) * 1.5,
+			"labels":     map[string]any{"node": fmt.Sprintf("worker-%d", i)},
+		})
+	}
+	if _, err := rw.Index(documents, indexers.IndexingOpts{MetricName: "cpu-kubelet"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != len(documents) {
+		t.Fatalf("expected %d series, got %d", len(documents), len(received))
+	}
+	for i, s := range received {
+		expected := []prompb.Label{
+			{Name: "__name__", Value: "kube_burner_cpu_kubelet"},
+			{Name: "node", Value: fmt.Sprintf("worker-%d", i)},
+			{Name: "uuid", Value: "6e2a9e4a-ef2c-4bd1-9b2f-5eb3d4f1e1a2"},
+		}
+		if !reflect.DeepEqual(s.Labels, expected) {
+			t.Fatalf("series %d: expected labels %v, got %v", i, expected, s.Labels)
+		}
+		sample := prompb.Sample{Value: float64(i) * 1.5, Timestamp: time.Date(2024, 1, 1, 0, 0, i, 0, time.UTC).UnixMilli()}
+		if len(s.Samples) != 1 || s.Samples[0].Value != sample.Value || s.Samples[0].Timestamp != sample.Timestamp {
+			t.Fatalf("series %d: expected sample %v, got %v", i, sample, s.Samples)
+		}
+	}
+}
+
+func seriesLabels(series []prompb.TimeSeries) map[string]map[string]string {
+	labelsByQuantile := map[string]map[string]string{}
+	for _, s := range series {
+		labels := map[string]string{}
+		for _, l := range s.Labels {
+			labels[l.Name] = l.Value
+		}
+		labelsByQuantile[labels["__name__"]+"/"+labels["quantile"]] = labels
+	}
+	return labelsByQuantile
+}
+
+func TestToRemoteWriteSeriesQuantiles(t *testing.T) {
+	docMap := map[string]any{
+		"metricName":   "podLatencyQuantilesMeasurement",
+		"quantileName": "Ready",
+		"uuid":         "uuid",
+		"jobName":      "job",
+		"timestamp":    "2024-01-01T00:00:00Z",
+		"P99":          99.0,
+		"P999":         99.9,
+		"P5":           5.0,
+		"P05":          0.5,
+		"P50":          50.0,
+		"min":          1.0,
+		"max":          100.0,
+		"avg":          40.0,
+	}
+	expected := map[string]float64{
+		"kube_burner_podLatencyQuantilesMeasurement/0.99":  99,
+		"kube_burner_podLatencyQuantilesMeasurement/0.999": 99.9,
+		"kube_burner_podLatencyQuantilesMeasurement/0.05":  5,
+		"kube_burner_podLatencyQuantilesMeasurement/0.005": 0.5,
+		"kube_burner_podLatencyQuantilesMeasurement/0.5":   50,
+		"kube_burner_podLatencyQuantilesMeasurement/0":     1,
+		"kube_burner_podLatencyQuantilesMeasurement/1":     100,
+		"kube_burner_podLatencyQuantilesMeasurement_avg/":  40,
+	}
+	series := toRemoteWriteSeries(docMap, "podLatencyQuantilesMeasurement")
+	if len(series) != len(expected) {
+		t.Fatalf("expected %d series, got %d", len(expected), len(series))
+	}
+	labels := seriesLabels(series)
+	for _, s := range series {
+		l := map[string]string{}
+		for _, label := range s.Labels {
+			l[label.Name] = label.Value
+		}
+		key := l["__name__"] + "/" + l["quantile"]
+		value, ok := expected[key]
+		if !ok {
+			t.Errorf("unexpected series %s", key)
+			continue
+		}
+		if s.Samples[0].Value != value {
+			t.Errorf("%s: expected %v, got %v", key, value, s.Samples[0].Value)
+		}
+		if l[remoteWriteJobLabel] != "job" || l["uuid"] != "uuid" || l["quantileName"] != "Ready" {
+			t.Errorf("%s: unexpected labels %v", key, l)
+		}
+	}
+	if len(labels) != len(expected) {
+		t.Errorf("expected %d distinct series, got %d", len(expected), len(labels))
+	}
+}
+
+func TestToRemoteWriteSeriesLabels(t *testing.T) {
+	docMap := map[string]any{
+		"metricName": "cpu-kubelet",
+		"uuid":       "uuid",
+		"jobName":    "kube-burner-job",
+		"timestamp":  "2024-01-01T00:00:00Z",
+		"value":      1.5,
+		"labels": map[string]any{
+			"__name__": "ignored",
+			"job":      "kubelet",
+			"node":     "worker-0",
+		},
+	}
+	series := toRemoteWriteSeries(docMap, "cpu-kubelet")
+	if len(series) != 1 {
+		t.Fatalf("expected 1 series, got %d", len(series))
+	}
+	labels := map[string]string{}
+	for _, l := range series[0].Labels {
+		labels[l.Name] = l.Value
+	}
+	expected := map[string]string{
+		"__name__":          "kube_burner_cpu_kubelet",
+		"uuid":              "uuid",
+		"job":               "kubelet",
+		"node":              "worker-0",
+		remoteWriteJobLabel: "kube-burner-job",
+	}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Errorf("expected labels %v, got %v", expected, labels)
+	}
+	if series[0].Labels[0].Name != "__name__" {
+		t.Errorf("expected labels sorted by name, got %v", series[0].Labels)
+	}
+}
+
+func TestQuantileLabel(t *testing.T) {
+	tests := []struct {
+		field    string
+		expected string
+		ok       bool
+	}{
+		{"P99", "0.99", true},
+		{"P95", "0.95", true},
+		{"P50", "0.5", true},
+		{"P999", "0.999", true},
+		{"P9999", "0.9999", true},
+		{"P5", "0.05", true},
+		{"P05", "0.005", true},
+		{"P100", "1", true},
+		{"P", "", false},
+		{"avg", "", false},
+		{"quantileName", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := quantileLabel(tt.field)
+		if got != tt.expected || ok != tt.ok {
+			t.Errorf("quantileLabel(%q) = %q, %v, expected %q, %v", tt.field, got, ok, tt.expected, tt.ok)
+		}
+	}
+}
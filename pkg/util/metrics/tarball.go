@@ -24,6 +24,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/cloud-bulldozer/go-commons/v2/indexers"
 	log "github.com/sirupsen/logrus"
@@ -87,7 +88,14 @@ func ImportTarball(tarball string, indexer *indexers.Indexer) error {
 		if err == io.EOF {
 			break
 		}
-		_, err = io.Copy(&rawData, tr)
+		var reader io.Reader = tr
+		// Files written by the local indexer with gzip enabled
+		if strings.HasSuffix(hdr.Name, ".gz") {
+			if reader, err = gzip.NewReader(tr); err != nil {
+				return fmt.Errorf("could not create gzip reader for %s: %v", hdr.Name, err)
+			}
+		}
+		_, err = io.Copy(&rawData, reader)
 		json.Unmarshal(rawData.Bytes(), &metrics)
 		rawData.Reset()
 		if err != nil {
@@ -0,0 +1,139 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloud-bulldozer/go-commons/v2/indexers"
+	"github.com/kube-burner/kube-burner/pkg/config"
+	"github.com/kube-burner/kube-burner/pkg/util"
+	log "github.com/sirupsen/logrus"
+)
+
+// Date layout exposed to index name templates
+const indexDateLayout = "2006.01.02"
+
+// templatedIndexer renders the index name of every document from a go-template,
+// documents are sent to an indexer per rendered index name
+type templatedIndexer struct {
+	indexerConfig config.IndexerConfig
+	indexTemplate string
+	indexers      map[string]*indexers.Indexer
+}
+
+// indexTemplateData holds the data available in index name templates
+type indexTemplateData struct {
+	Date       string
+	UUID       string
+	JobName    string
+	MetricName string
+}
+
+// newTemplatedIndexer returns an indexer rendering the index name template of indexerConfig for every document
+func newTemplatedIndexer(indexerConfig config.IndexerConfig) (*templatedIndexer, error) {
+	ti := templatedIndexer{
+		indexerConfig: indexerConfig,
+		indexTemplate: indexerConfig.Index,
+		indexers:      make(map[string]*indexers.Indexer),
+	}
+	// Render the template once to catch errors early
+	if _, err := ti.renderIndex(indexTemplateData{Date: time.Now().Format(indexDateLayout)}); err != nil {
+		return nil, fmt.Errorf("invalid index template %s: %v", ti.indexTemplate, err)
+	}
+	return &ti, nil
+}
+
+// Index groups the documents by rendered index name and sends each group to its indexer
+func (ti *templatedIndexer) Index(documents []any, opts indexers.IndexingOpts) (string, error) {
+	var indexNames []string
+	groups := make(map[string][]any)
+	for _, document := range documents {
+		indexName, err := ti.renderIndex(documentTemplateData(document, opts.MetricName))
+		if err != nil {
+			return "", err
+		}
+		if _, ok := groups[indexName]; !ok {
+			indexNames = append(indexNames, indexName)
+		}
+		groups[indexName] = append(groups[indexName], document)
+	}
+	var msgs []string
+	for _, indexName := range indexNames {
+		indexer, ok := ti.indexers[indexName]
+		if !ok {
+			indexerConfig := ti.indexerConfig
+			indexerConfig.Index = indexName
+			log.Debugf("Creating %s indexer for index %s", indexerConfig.Type, indexName)
+			var err error
+			if indexer, err = NewIndexer(indexerConfig); err != nil {
+				return "", fmt.Errorf("error creating indexer for index %s: %v", indexName, err)
+			}
+			ti.indexers[indexName] = indexer
+		}
+		msg, err := (*indexer).Index(groups[indexName], opts)
+		if err != nil {
+			return "", err
+		}
+		msgs = append(msgs, msg)
+	}
+	return strings.Join(msgs, ", "), nil
+}
+
+func (ti *templatedIndexer) renderIndex(data indexTemplateData) (string, error) {
+	indexName, err := util.RenderTemplate([]byte(ti.indexTemplate), data, util.MissingKeyError, []string{})
+	if err != nil {
+		return "", err
+	}
+	// Index names must be lowercase
+	return strings.ToLower(string(indexName)), nil
+}
+
+// documentTemplateData extracts the template data from the document, the date is taken from its timestamp
+func documentTemplateData(document any, metricName string) indexTemplateData {
+	data := indexTemplateData{
+		Date:       time.Now().UTC().Format(indexDateLayout),
+		MetricName: metricName,
+	}
+	j, err := json.Marshal(document)
+	if err != nil {
+		return data
+	}
+	var docMap map[string]any
+	json.Unmarshal(j, &docMap)
+	if ts, ok := docMap["timestamp"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			data.Date = t.UTC().Format(indexDateLayout)
+		}
+	}
+	if uuid, ok := docMap["uuid"].(string); ok {
+		data.UUID = uuid
+	}
+	if metricName, ok := docMap["metricName"].(string); ok {
+		data.MetricName = metricName
+	}
+	if jobName, ok := docMap["jobName"].(string); ok {
+		data.JobName = jobName
+	} else if jobConfig, ok := docMap["jobConfig"].(map[string]any); ok {
+		// Job summaries carry the job name in the job configuration
+		if jobName, ok := jobConfig["name"].(string); ok {
+			data.JobName = jobName
+		}
+	}
+	return data
+}
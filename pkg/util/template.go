@@ -16,12 +16,20 @@ package util
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
+	"hash/fnv"
 	"io"
+	"maps"
+	"math/rand"
 	"net/netip"
+	"net/url"
 	"os"
+	"path"
+	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"text/template"
 
 	sprig "github.com/Masterminds/sprig/v3"
@@ -38,6 +46,8 @@ const (
 
 var funcMap = sprig.GenericFuncMap()
 
+const alphaChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
 func init() {
 	AddRenderingFunction("Binomial", combin.Binomial)
 	AddRenderingFunction("IndexToCombination", combin.IndexToCombination)
@@ -92,8 +102,115 @@ func AddRenderingFunction(name string, function any) {
 
 // RenderTemplate renders a go-template
 func RenderTemplate(original []byte, inputData any, options templateOption, functionTemplates []string) ([]byte, error) {
+	return renderTemplate(original, inputData, options, functionTemplates, nil)
+}
+
+// RenderObjectTemplate renders an object template. Unlike RenderTemplate, the env function
+// follows the given missing key policy, failing on unset environment variables with missingkey=error.
+// The seeded random functions are seeded with the given seed, and fileFuncs, usually built with TemplateFileFuncs,
+// are added to the template functions
+func RenderObjectTemplate(original []byte, inputData any, options templateOption, functionTemplates []string, seed string, fileFuncs template.FuncMap) ([]byte, error) {
+	funcs := template.FuncMap{"env": envFunc(options)}
+	maps.Copy(funcs, seededRandFuncs(seed))
+	maps.Copy(funcs, fileFuncs)
+	return renderTemplate(original, inputData, options, functionTemplates, funcs)
+}
+
+// TemplateFileFuncs returns the readFile and readFileBase64 functions, which load files relative to the directory
+// of the given template through the read function. Paths escaping the template directory are rejected, and file
+// contents are cached since the same file is usually rendered once per object
+func TemplateFileFuncs(templatePath string, read func(location string) (io.Reader, error)) template.FuncMap {
+	var mu sync.Mutex
+	cache := make(map[string]string)
+	readFile := func(name string) (string, error) {
+		if !filepath.IsLocal(name) {
+			return "", fmt.Errorf("file %s is outside the template directory", name)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if content, ok := cache[name]; ok {
+			return content, nil
+		}
+		f, err := read(templateFileLocation(templatePath, name))
+		if err != nil {
+			return "", fmt.Errorf("failed to open file %s: %v", name, err)
+		}
+		if c, ok := f.(io.Closer); ok {
+			defer c.Close()
+		}
+		content, err := io.ReadAll(f)
+		if err != nil {
+			return "", fmt.Errorf("failed to read file %s: %v", name, err)
+		}
+		cache[name] = string(content)
+		return cache[name], nil
+	}
+	return template.FuncMap{
+		"readFile": readFile,
+		"readFileBase64": func(name string) (string, error) {
+			content, err := readFile(name)
+			if err != nil {
+				return "", err
+			}
+			return base64.StdEncoding.EncodeToString([]byte(content)), nil
+		},
+	}
+}
+
+// templateFileLocation returns the location of the given file relative to the template directory,
+// templates loaded from an URL resolve their files against the same URL
+func templateFileLocation(templatePath, name string) string {
+	u, err := url.Parse(templatePath)
+	if err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		u.Path = path.Join(path.Dir(u.Path), filepath.ToSlash(name))
+		return u.String()
+	}
+	return filepath.Join(filepath.Dir(templatePath), name)
+}
+
+// seededRandFuncs returns pseudo-random functions which produce the same value given the same seed and keys,
+// the keys are usually the iteration and replica of the object, i.e: {{ randAlphaSeeded 8 .Iteration .Replica }}
+func seededRandFuncs(seed string) template.FuncMap {
+	newRand := func(keys []any) *rand.Rand {
+		h := fnv.New64a()
+		h.Write([]byte(seed))
+		for _, k := range keys {
+			fmt.Fprintf(h, "/%v", k)
+		}
+		return rand.New(rand.NewSource(int64(h.Sum64())))
+	}
+	return template.FuncMap{
+		"randAlphaSeeded": func(length int, keys ...any) string {
+			r := newRand(keys)
+			b := make([]byte, length)
+			for i := range b {
+				b[i] = alphaChars[r.Intn(len(alphaChars))]
+			}
+			return string(b)
+		},
+		"randIntSeeded": func(minValue, maxValue int, keys ...any) (int, error) {
+			if maxValue <= minValue {
+				return 0, fmt.Errorf("max value %d must be greater than min value %d", maxValue, minValue)
+			}
+			return minValue + newRand(keys).Intn(maxValue-minValue), nil
+		},
+	}
+}
+
+// envFunc returns a function looking up the given environment variable according to the missing key policy
+func envFunc(options templateOption) func(string) (string, error) {
+	return func(name string) (string, error) {
+		value, ok := os.LookupEnv(name)
+		if !ok && options == MissingKeyError {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+		return value, nil
+	}
+}
+
+func renderTemplate(original []byte, inputData any, options templateOption, functionTemplates []string, funcs template.FuncMap) ([]byte, error) {
 	var rendered bytes.Buffer
-	t, err := template.New("").Option(string(options)).Funcs(funcMap).Parse(string(original))
+	t, err := template.New("").Option(string(options)).Funcs(funcMap).Funcs(funcs).Parse(string(original))
 	if err != nil {
 		return nil, fmt.Errorf("parsing error: %s", err)
 	}
@@ -0,0 +1,50 @@
+// Copyright 2025 The Kube-burner Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ReadFile isn't restricted to the working directory, unlike readFile
+func TestReadFile(t *testing.T) {
+	readFile := funcMap["ReadFile"].(func(string) (string, error))
+	filePath := filepath.Join(t.TempDir(), "content.txt")
+	if err := os.WriteFile(filePath, []byte("content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if content, err := readFile(filePath); err != nil || content != "content" {
+		t.Errorf("ReadFile(%q): unexpected result %q, %v", filePath, content, err)
+	}
+}
+
+func TestTemplateFileFuncsTraversal(t *testing.T) {
+	read := func(location string) (io.Reader, error) {
+		return strings.NewReader(location), nil
+	}
+	readFile := TemplateFileFuncs("templates/deployment.yml", read)["readFile"].(func(string) (string, error))
+	for _, name := range []string{"/etc/passwd", "../secret.txt"} {
+		if _, err := readFile(name); err == nil {
+			t.Errorf("readFile(%q): expected error", name)
+		}
+	}
+	if content, err := readFile("payloads/blob.json"); err != nil || content != "templates/payloads/blob.json" {
+		t.Errorf("readFile: unexpected result %q, %v", content, err)
+	}
+}